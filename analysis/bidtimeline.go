@@ -0,0 +1,170 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package analysis provides analyses of data obtained from MEV relays.
+package analysis
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+)
+
+// BidPoint is a single bid on a bid timeline.
+type BidPoint struct {
+	// Offset is the time of the bid relative to the start of the slot.
+	// Bids received before the start of the slot have a negative offset.
+	Offset        time.Duration
+	Timestamp     time.Time
+	BuilderPubkey phase0.BLSPubKey
+	BlockHash     phase0.Hash32
+	Value         *big.Int
+}
+
+// BidTimeline is the timeline of bids received for a slot.
+type BidTimeline struct {
+	// Slot is the slot for which the bids were received.
+	Slot phase0.Slot
+	// SlotStart is the time at which the slot started.
+	SlotStart time.Time
+	// Bids are all bids for the slot, in timestamp order.
+	Bids []*BidPoint
+	// HighestBids are the bids that increased the highest bid value,
+	// in timestamp order.  This is the highest bid over time.
+	HighestBids []*BidPoint
+	// BuilderBids are the bids for each builder, in timestamp order.
+	BuilderBids map[phase0.BLSPubKey][]*BidPoint
+}
+
+// NewBidTimeline creates a bid timeline for the given slot from the bids
+// received by one or more relays.
+func NewBidTimeline(slot phase0.Slot,
+	slotStart time.Time,
+	bids []*v1.BidTraceWithTimestamp,
+) (
+	*BidTimeline,
+	error,
+) {
+	timeline := &BidTimeline{
+		Slot:        slot,
+		SlotStart:   slotStart,
+		Bids:        make([]*BidPoint, 0, len(bids)),
+		HighestBids: make([]*BidPoint, 0),
+		BuilderBids: make(map[phase0.BLSPubKey][]*BidPoint),
+	}
+
+	for _, bid := range bids {
+		if bid == nil {
+			continue
+		}
+		if bid.Slot != slot {
+			return nil, fmt.Errorf("bid for slot %d found in timeline for slot %d", bid.Slot, slot)
+		}
+		if bid.Value == nil {
+			return nil, fmt.Errorf("bid %#x has no value", bid.BlockHash)
+		}
+		timeline.Bids = append(timeline.Bids, &BidPoint{
+			Offset:        bid.Timestamp.Sub(slotStart),
+			Timestamp:     bid.Timestamp,
+			BuilderPubkey: bid.BuilderPubkey,
+			BlockHash:     bid.BlockHash,
+			Value:         bid.Value,
+		})
+	}
+
+	sort.SliceStable(timeline.Bids, func(i int, j int) bool {
+		return timeline.Bids[i].Timestamp.Before(timeline.Bids[j].Timestamp)
+	})
+
+	for _, bid := range timeline.Bids {
+		if len(timeline.HighestBids) == 0 ||
+			bid.Value.Cmp(timeline.HighestBids[len(timeline.HighestBids)-1].Value) > 0 {
+			timeline.HighestBids = append(timeline.HighestBids, bid)
+		}
+		timeline.BuilderBids[bid.BuilderPubkey] = append(timeline.BuilderBids[bid.BuilderPubkey], bid)
+	}
+
+	return timeline, nil
+}
+
+// HighestBidAt returns the highest bid received up to and including the
+// given offset from the start of the slot.
+// Will return nil if no bids had been received by that time.
+func (t *BidTimeline) HighestBidAt(offset time.Duration) *BidPoint {
+	return highestAt(t.HighestBids, offset)
+}
+
+// BuilderHighestBidAt returns the highest bid received from the given builder
+// up to and including the given offset from the start of the slot.
+// Will return nil if no bids had been received from the builder by that time.
+func (t *BidTimeline) BuilderHighestBidAt(builder phase0.BLSPubKey, offset time.Duration) *BidPoint {
+	var highest *BidPoint
+	for _, bid := range t.BuilderBids[builder] {
+		if bid.Offset > offset {
+			break
+		}
+		if highest == nil || bid.Value.Cmp(highest.Value) > 0 {
+			highest = bid
+		}
+	}
+
+	return highest
+}
+
+// HighestBidsAt returns the highest bid at each of the given offsets from the
+// start of the slot, for example -time.Second and 0 for t-1s and t-0s.
+// Offsets at which no bids had been received have a nil entry.
+func (t *BidTimeline) HighestBidsAt(offsets ...time.Duration) map[time.Duration]*BidPoint {
+	res := make(map[time.Duration]*BidPoint, len(offsets))
+	for _, offset := range offsets {
+		res[offset] = t.HighestBidAt(offset)
+	}
+
+	return res
+}
+
+// WinningBid returns the bid on the timeline that matches the delivered
+// payload.  The offset of the returned bid is the time of the winning bid
+// relative to the start of the slot.
+// Will return nil if the delivered payload is not on the timeline.
+func (t *BidTimeline) WinningBid(delivered *v1.BidTrace) *BidPoint {
+	if delivered == nil {
+		return nil
+	}
+
+	// The same block can be submitted more than once; bids are in timestamp
+	// order so the first match is the earliest submission.
+	for _, bid := range t.Bids {
+		if bid.BlockHash == delivered.BlockHash {
+			return bid
+		}
+	}
+
+	return nil
+}
+
+// highestAt returns the last bid in the monotonic list at or before the offset.
+func highestAt(bids []*BidPoint, offset time.Duration) *BidPoint {
+	idx := sort.Search(len(bids), func(i int) bool {
+		return bids[i].Offset > offset
+	})
+	if idx == 0 {
+		return nil
+	}
+
+	return bids[idx-1]
+}
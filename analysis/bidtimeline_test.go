@@ -0,0 +1,121 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-relay-client/analysis"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func bid(slot phase0.Slot, builder byte, block byte, value int64, timestamp time.Time) *v1.BidTraceWithTimestamp {
+	return &v1.BidTraceWithTimestamp{
+		Slot:          slot,
+		BuilderPubkey: phase0.BLSPubKey{builder},
+		BlockHash:     phase0.Hash32{block},
+		Value:         big.NewInt(value),
+		Timestamp:     timestamp,
+	}
+}
+
+func TestBidTimeline(t *testing.T) {
+	slotStart := time.Unix(1700000000, 0)
+	bids := []*v1.BidTraceWithTimestamp{
+		bid(1, 0x02, 0x04, 300, slotStart.Add(-500*time.Millisecond)),
+		bid(1, 0x01, 0x01, 100, slotStart.Add(-2*time.Second)),
+		bid(1, 0x02, 0x02, 200, slotStart.Add(-1500*time.Millisecond)),
+		bid(1, 0x01, 0x03, 150, slotStart.Add(-1200*time.Millisecond)),
+		bid(1, 0x01, 0x05, 250, slotStart.Add(200*time.Millisecond)),
+		bid(1, 0x02, 0x04, 300, slotStart.Add(300*time.Millisecond)),
+	}
+
+	timeline, err := analysis.NewBidTimeline(1, slotStart, bids)
+	require.NoError(t, err)
+	require.Len(t, timeline.Bids, 6)
+	require.Equal(t, -2*time.Second, timeline.Bids[0].Offset)
+
+	require.Len(t, timeline.HighestBids, 3)
+	require.Equal(t, int64(100), timeline.HighestBids[0].Value.Int64())
+	require.Equal(t, int64(200), timeline.HighestBids[1].Value.Int64())
+	require.Equal(t, int64(300), timeline.HighestBids[2].Value.Int64())
+
+	require.Len(t, timeline.BuilderBids, 2)
+	require.Len(t, timeline.BuilderBids[phase0.BLSPubKey{0x01}], 3)
+	require.Len(t, timeline.BuilderBids[phase0.BLSPubKey{0x02}], 3)
+
+	require.Nil(t, timeline.HighestBidAt(-3*time.Second))
+	require.Equal(t, int64(200), timeline.HighestBidAt(-time.Second).Value.Int64())
+	require.Equal(t, int64(300), timeline.HighestBidAt(0).Value.Int64())
+
+	atOffsets := timeline.HighestBidsAt(-time.Second, 0)
+	require.Len(t, atOffsets, 2)
+	require.Equal(t, int64(200), atOffsets[-time.Second].Value.Int64())
+	require.Equal(t, int64(300), atOffsets[0].Value.Int64())
+
+	require.Equal(t, int64(150), timeline.BuilderHighestBidAt(phase0.BLSPubKey{0x01}, 0).Value.Int64())
+	require.Equal(t, int64(250), timeline.BuilderHighestBidAt(phase0.BLSPubKey{0x01}, time.Second).Value.Int64())
+	require.Nil(t, timeline.BuilderHighestBidAt(phase0.BLSPubKey{0x03}, time.Second))
+
+	winning := timeline.WinningBid(&v1.BidTrace{Slot: 1, BlockHash: phase0.Hash32{0x04}})
+	require.NotNil(t, winning)
+	require.Equal(t, -500*time.Millisecond, winning.Offset)
+	require.Nil(t, timeline.WinningBid(&v1.BidTrace{Slot: 1, BlockHash: phase0.Hash32{0x09}}))
+	require.Nil(t, timeline.WinningBid(nil))
+}
+
+func TestBidTimelineErrors(t *testing.T) {
+	slotStart := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name string
+		bids []*v1.BidTraceWithTimestamp
+		err  string
+	}{
+		{
+			name: "Empty",
+		},
+		{
+			name: "NilBid",
+			bids: []*v1.BidTraceWithTimestamp{nil},
+		},
+		{
+			name: "WrongSlot",
+			bids: []*v1.BidTraceWithTimestamp{bid(2, 0x01, 0x01, 100, slotStart)},
+			err:  "bid for slot 2 found in timeline for slot 1",
+		},
+		{
+			name: "ValueMissing",
+			bids: []*v1.BidTraceWithTimestamp{{Slot: 1, BlockHash: phase0.Hash32{0x01}}},
+			err:  "bid 0x0100000000000000000000000000000000000000000000000000000000000000 has no value",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			timeline, err := analysis.NewBidTimeline(1, slotStart, test.bids)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Empty(t, timeline.Bids)
+				require.Nil(t, timeline.HighestBidAt(0))
+			}
+		})
+	}
+}
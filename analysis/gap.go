@@ -0,0 +1,191 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// GapRelay is a relay that provides both delivered payloads and received bids.
+type GapRelay interface {
+	client.DeliveredBidTraceProvider
+	client.ReceivedBidTracesProvider
+}
+
+// SlotGap compares the payload delivered for a slot with the best bid that
+// was available at the start of the slot.
+type SlotGap struct {
+	Slot      phase0.Slot
+	SlotStart time.Time
+	// Delivered is the delivered payload, or nil if no relay delivered one.
+	Delivered *v1.BidTrace
+	// DeliveredRelays are the relays that reported delivering the payload.
+	DeliveredRelays []string
+	// BestBid is the highest bid received by any relay by the start of the
+	// slot, or nil if there were none.
+	BestBid *v1.BidTraceWithTimestamp
+	// BestBidRelays are the relays that had received the best bid by the
+	// start of the slot.
+	BestBidRelays []string
+	// Gap is the value of the best bid less the delivered value.  It is
+	// negative if the delivered payload was worth more than the best bid at
+	// the start of the slot, and nil if there is no delivery or no best bid.
+	Gap *big.Int
+	// GapPercent is the gap as a percentage of the best bid's value.
+	GapPercent float64
+}
+
+// NewSlotGap creates a slot gap from the deliveries and received bids of
+// each relay, keyed by relay name.  Deliveries and bids are reconciled
+// across relays by block hash.
+func NewSlotGap(slot phase0.Slot,
+	slotStart time.Time,
+	deliveries map[string]*v1.BidTrace,
+	received map[string][]*v1.BidTraceWithTimestamp,
+) (
+	*SlotGap,
+	error,
+) {
+	gap := &SlotGap{
+		Slot:            slot,
+		SlotStart:       slotStart,
+		DeliveredRelays: make([]string, 0),
+		BestBidRelays:   make([]string, 0),
+	}
+
+	for relay, delivery := range deliveries {
+		if delivery == nil {
+			continue
+		}
+		if delivery.Slot != slot {
+			return nil, fmt.Errorf("delivery for slot %d from %s found in gap for slot %d", delivery.Slot, relay, slot)
+		}
+		if delivery.Value == nil {
+			return nil, fmt.Errorf("delivery %#x from %s has no value", delivery.BlockHash, relay)
+		}
+		if gap.Delivered != nil && gap.Delivered.BlockHash != delivery.BlockHash {
+			return nil, fmt.Errorf("relays disagree on delivered payload for slot %d", slot)
+		}
+		gap.Delivered = delivery
+		gap.DeliveredRelays = append(gap.DeliveredRelays, relay)
+	}
+
+	// Relays can see the same block at different times, so find the
+	// relays that had each block by the slot start.
+	blocks := make(map[phase0.Hash32]*v1.BidTraceWithTimestamp)
+	blockRelays := make(map[phase0.Hash32]map[string]struct{})
+	for relay, bids := range received {
+		for _, bid := range bids {
+			if bid == nil || bid.Timestamp.After(slotStart) {
+				continue
+			}
+			if bid.Slot != slot {
+				return nil, fmt.Errorf("bid for slot %d from %s found in gap for slot %d", bid.Slot, relay, slot)
+			}
+			if bid.Value == nil {
+				return nil, fmt.Errorf("bid %#x from %s has no value", bid.BlockHash, relay)
+			}
+			if _, exists := blockRelays[bid.BlockHash]; !exists {
+				blockRelays[bid.BlockHash] = make(map[string]struct{})
+			}
+			blockRelays[bid.BlockHash][relay] = struct{}{}
+			if existing, exists := blocks[bid.BlockHash]; !exists || bid.Timestamp.Before(existing.Timestamp) {
+				blocks[bid.BlockHash] = bid
+			}
+		}
+	}
+	for _, bid := range blocks {
+		if gap.BestBid == nil || bid.Value.Cmp(gap.BestBid.Value) > 0 ||
+			(bid.Value.Cmp(gap.BestBid.Value) == 0 && bid.Timestamp.Before(gap.BestBid.Timestamp)) {
+			gap.BestBid = bid
+		}
+	}
+	if gap.BestBid != nil {
+		for relay := range blockRelays[gap.BestBid.BlockHash] {
+			gap.BestBidRelays = append(gap.BestBidRelays, relay)
+		}
+	}
+	sort.Strings(gap.DeliveredRelays)
+	sort.Strings(gap.BestBidRelays)
+
+	if gap.Delivered != nil && gap.BestBid != nil {
+		gap.Gap = new(big.Int).Sub(gap.BestBid.Value, gap.Delivered.Value)
+		if gap.BestBid.Value.Sign() > 0 {
+			gap.GapPercent, _ = new(big.Float).Quo(
+				new(big.Float).Mul(new(big.Float).SetInt(gap.Gap), big.NewFloat(100)),
+				new(big.Float).SetInt(gap.BestBid.Value),
+			).Float64()
+		}
+	}
+
+	return gap, nil
+}
+
+// ObtainSlotGap obtains the deliveries and received bids for a slot from
+// the relays and creates a slot gap from them.
+func ObtainSlotGap(ctx context.Context,
+	relays []GapRelay,
+	slot phase0.Slot,
+	slotStart time.Time,
+) (
+	*SlotGap,
+	error,
+) {
+	var mu sync.Mutex
+	deliveries := make(map[string]*v1.BidTrace, len(relays))
+	received := make(map[string][]*v1.BidTraceWithTimestamp, len(relays))
+	errs := make([]error, len(relays))
+
+	var wg sync.WaitGroup
+	for i := range relays {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			relay := relays[i]
+			delivery, err := relay.DeliveredBidTrace(ctx, slot)
+			if err != nil {
+				errs[i] = errors.Wrap(err, fmt.Sprintf("failed to obtain delivery from %s", relay.Name()))
+				return
+			}
+			bids, err := relay.ReceivedBidTraces(ctx, slot)
+			if err != nil {
+				errs[i] = errors.Wrap(err, fmt.Sprintf("failed to obtain received bids from %s", relay.Name()))
+				return
+			}
+			mu.Lock()
+			deliveries[relay.Name()] = delivery
+			received[relay.Name()] = bids
+			mu.Unlock()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewSlotGap(slot, slotStart, deliveries, received)
+}
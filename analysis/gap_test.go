@@ -0,0 +1,200 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-relay-client/analysis"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// gapRelay is a mock relay that provides deliveries and received bids.
+type gapRelay struct {
+	deliveryRelay
+	bids []*v1.BidTraceWithTimestamp
+}
+
+func (r *gapRelay) ReceivedBidTraces(_ context.Context, _ phase0.Slot) ([]*v1.BidTraceWithTimestamp, error) {
+	return r.bids, nil
+}
+
+func TestNewSlotGap(t *testing.T) {
+	slotStart := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name            string
+		deliveries      map[string]*v1.BidTrace
+		received        map[string][]*v1.BidTraceWithTimestamp
+		err             string
+		delivered       *phase0.Hash32
+		deliveredRelays []string
+		best            *phase0.Hash32
+		bestRelays      []string
+		gap             *big.Int
+		gapPercent      float64
+	}{
+		{
+			name:       "Empty",
+			bestRelays: []string{},
+		},
+		{
+			name: "DeliveryWrongSlot",
+			deliveries: map[string]*v1.BidTrace{
+				"relay1": delivery(2, 0x01, 0x0a, 100),
+			},
+			err: "delivery for slot 2 from relay1 found in gap for slot 1",
+		},
+		{
+			name: "DeliveriesDisagree",
+			deliveries: map[string]*v1.BidTrace{
+				"relay1": delivery(1, 0x01, 0x0a, 100),
+				"relay2": delivery(1, 0x01, 0x0b, 100),
+			},
+			err: "relays disagree on delivered payload for slot 1",
+		},
+		{
+			name: "BidNoValue",
+			received: map[string][]*v1.BidTraceWithTimestamp{
+				"relay1": {{Slot: 1, BlockHash: phase0.Hash32{0x0a}, Timestamp: slotStart}},
+			},
+			err: "bid 0x0a00000000000000000000000000000000000000000000000000000000000000 from relay1 has no value",
+		},
+		{
+			name: "NoDelivery",
+			received: map[string][]*v1.BidTraceWithTimestamp{
+				"relay1": {bid(1, 0x01, 0x0a, 100, slotStart.Add(-time.Second))},
+			},
+			best:       &phase0.Hash32{0x0a},
+			bestRelays: []string{"relay1"},
+		},
+		{
+			name: "Gap",
+			deliveries: map[string]*v1.BidTrace{
+				"relay1": delivery(1, 0x01, 0x0a, 800),
+				"relay2": delivery(1, 0x01, 0x0a, 800),
+				"relay3": nil,
+			},
+			received: map[string][]*v1.BidTraceWithTimestamp{
+				"relay1": {
+					bid(1, 0x01, 0x0a, 800, slotStart.Add(-time.Second)),
+					// Higher bid but after the slot start.
+					bid(1, 0x02, 0x0c, 2000, slotStart.Add(time.Second)),
+				},
+				"relay2": {
+					bid(1, 0x01, 0x0a, 800, slotStart.Add(-time.Second)),
+				},
+				"relay3": {
+					bid(1, 0x02, 0x0b, 1000, slotStart.Add(-500*time.Millisecond)),
+				},
+				"relay4": {
+					// Same block as relay 3, seen in time.
+					bid(1, 0x02, 0x0b, 1000, slotStart),
+				},
+			},
+			delivered:       &phase0.Hash32{0x0a},
+			deliveredRelays: []string{"relay1", "relay2"},
+			best:            &phase0.Hash32{0x0b},
+			bestRelays:      []string{"relay3", "relay4"},
+			gap:             big.NewInt(200),
+			gapPercent:      20,
+		},
+		{
+			name: "DeliveredAfterSlotStart",
+			deliveries: map[string]*v1.BidTrace{
+				"relay1": delivery(1, 0x02, 0x0c, 1200),
+			},
+			received: map[string][]*v1.BidTraceWithTimestamp{
+				"relay1": {
+					bid(1, 0x01, 0x0a, 1000, slotStart.Add(-time.Second)),
+					bid(1, 0x02, 0x0c, 1200, slotStart.Add(time.Second)),
+				},
+			},
+			delivered:       &phase0.Hash32{0x0c},
+			deliveredRelays: []string{"relay1"},
+			best:            &phase0.Hash32{0x0a},
+			bestRelays:      []string{"relay1"},
+			gap:             big.NewInt(-200),
+			gapPercent:      -20,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gap, err := analysis.NewSlotGap(1, slotStart, test.deliveries, test.received)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+
+			if test.delivered == nil {
+				require.Nil(t, gap.Delivered)
+			} else {
+				require.Equal(t, *test.delivered, gap.Delivered.BlockHash)
+				require.Equal(t, test.deliveredRelays, gap.DeliveredRelays)
+			}
+			if test.best == nil {
+				require.Nil(t, gap.BestBid)
+			} else {
+				require.Equal(t, *test.best, gap.BestBid.BlockHash)
+			}
+			require.Equal(t, test.bestRelays, gap.BestBidRelays)
+			require.Equal(t, test.gap, gap.Gap)
+			require.InDelta(t, test.gapPercent, gap.GapPercent, 1e-9)
+		})
+	}
+}
+
+func TestObtainSlotGap(t *testing.T) {
+	slotStart := time.Unix(1700000000, 0)
+
+	relays := []analysis.GapRelay{
+		&gapRelay{
+			deliveryRelay: deliveryRelay{
+				name: "relay1",
+				deliveries: map[phase0.Slot]*v1.BidTrace{
+					1: delivery(1, 0x01, 0x0a, 900),
+				},
+			},
+			bids: []*v1.BidTraceWithTimestamp{
+				bid(1, 0x01, 0x0a, 900, slotStart.Add(-time.Second)),
+			},
+		},
+		&gapRelay{
+			deliveryRelay: deliveryRelay{name: "relay2"},
+			bids: []*v1.BidTraceWithTimestamp{
+				bid(1, 0x02, 0x0b, 1000, slotStart.Add(-time.Second)),
+			},
+		},
+	}
+
+	gap, err := analysis.ObtainSlotGap(context.Background(), relays, 1, slotStart)
+	require.NoError(t, err)
+	require.Equal(t, []string{"relay1"}, gap.DeliveredRelays)
+	require.Equal(t, phase0.BLSPubKey{0x02}, gap.BestBid.BuilderPubkey)
+	require.Equal(t, []string{"relay2"}, gap.BestBidRelays)
+	require.Equal(t, big.NewInt(100), gap.Gap)
+	require.InDelta(t, 10.0, gap.GapPercent, 1e-9)
+
+	relays = append(relays, &gapRelay{deliveryRelay: deliveryRelay{name: "relay3", err: errors.New("mock error")}})
+	_, err = analysis.ObtainSlotGap(context.Background(), relays, 1, slotStart)
+	require.EqualError(t, err, "failed to obtain delivery from relay3: mock error")
+}
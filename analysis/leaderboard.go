@@ -0,0 +1,318 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// BuilderStats are the statistics for a single builder on a leaderboard.
+type BuilderStats struct {
+	Builder phase0.BLSPubKey
+	// BlocksWon is the number of slots for which the builder's payload was delivered.
+	BlocksWon int
+	// TotalValue is the total value of the builder's delivered payloads.
+	TotalValue *big.Int
+	// MedianValue is the median value of the builder's delivered payloads.
+	MedianValue *big.Int
+	// Share is the builder's share of all slots with a delivered payload.
+	Share float64
+	// RelayShares is the builder's share of each relay's deliveries.
+	RelayShares map[string]float64
+}
+
+// RelayStats are the statistics for a single relay on a leaderboard.
+type RelayStats struct {
+	Relay string
+	// Deliveries is the number of payloads delivered by the relay.
+	Deliveries int
+}
+
+type relayDelivery struct {
+	relay string
+	slot  phase0.Slot
+}
+
+type slotBlock struct {
+	slot      phase0.Slot
+	blockHash phase0.Hash32
+}
+
+// Leaderboard aggregates delivered payloads by builder and by relay.
+//
+// The same payload is often delivered by more than one relay.  It counts as
+// a single block won for the builder, but as a delivery for each relay.
+// Deliveries can be added incrementally as new slots arrive, and adding a
+// delivery that has already been seen has no effect.  It is safe for
+// concurrent use.
+type Leaderboard struct {
+	mu              sync.RWMutex
+	firstSlot       phase0.Slot
+	lastSlot        phase0.Slot
+	deliveries      map[relayDelivery]struct{}
+	blocks          map[slotBlock]struct{}
+	builderValues   map[phase0.BLSPubKey][]*big.Int
+	relayDeliveries map[string]int
+	relayBuilders   map[string]map[phase0.BLSPubKey]int
+}
+
+// NewLeaderboard creates an empty leaderboard.
+func NewLeaderboard() *Leaderboard {
+	return &Leaderboard{
+		deliveries:      make(map[relayDelivery]struct{}),
+		blocks:          make(map[slotBlock]struct{}),
+		builderValues:   make(map[phase0.BLSPubKey][]*big.Int),
+		relayDeliveries: make(map[string]int),
+		relayBuilders:   make(map[string]map[phase0.BLSPubKey]int),
+	}
+}
+
+// Add adds a payload delivered by a relay to the leaderboard.
+func (l *Leaderboard) Add(relay string, trace *v1.BidTrace) error {
+	if trace == nil {
+		return errors.New("no trace supplied")
+	}
+	if trace.Value == nil {
+		return fmt.Errorf("trace %#x has no value", trace.BlockHash)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delivery := relayDelivery{relay: relay, slot: trace.Slot}
+	if _, exists := l.deliveries[delivery]; exists {
+		return nil
+	}
+	l.deliveries[delivery] = struct{}{}
+
+	if len(l.deliveries) == 1 || trace.Slot < l.firstSlot {
+		l.firstSlot = trace.Slot
+	}
+	if trace.Slot > l.lastSlot {
+		l.lastSlot = trace.Slot
+	}
+
+	l.relayDeliveries[relay]++
+	if _, exists := l.relayBuilders[relay]; !exists {
+		l.relayBuilders[relay] = make(map[phase0.BLSPubKey]int)
+	}
+	l.relayBuilders[relay][trace.BuilderPubkey]++
+
+	block := slotBlock{slot: trace.Slot, blockHash: trace.BlockHash}
+	if _, exists := l.blocks[block]; !exists {
+		l.blocks[block] = struct{}{}
+		l.builderValues[trace.BuilderPubkey] = append(l.builderValues[trace.BuilderPubkey], new(big.Int).Set(trace.Value))
+	}
+
+	return nil
+}
+
+// Populate adds the payloads delivered by the relays for the slots from
+// first to last inclusive.  Relays are queried in parallel.  Relays that
+// provide delivered bid traces for many slots are queried for the whole
+// range at once, and others one slot at a time.
+func (l *Leaderboard) Populate(ctx context.Context,
+	relays []client.DeliveredBidTraceProvider,
+	first phase0.Slot,
+	last phase0.Slot,
+) error {
+	if last < first {
+		return errors.New("last slot before first slot")
+	}
+
+	errs := make([]error, len(relays))
+	var wg sync.WaitGroup
+	for i := range relays {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if batchProvider, isBatchProvider := relays[i].(client.DeliveredBidTracesProvider); isBatchProvider {
+				errs[i] = l.populateBatch(ctx, batchProvider, first, last)
+			} else {
+				errs[i] = l.populateSlots(ctx, relays[i], first, last)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// populateBatch adds the payloads delivered by the relay for the slots from
+// first to last inclusive, obtained with a single batch request.
+func (l *Leaderboard) populateBatch(ctx context.Context,
+	relay client.DeliveredBidTracesProvider,
+	first phase0.Slot,
+	last phase0.Slot,
+) error {
+	slots := make([]phase0.Slot, 0, last-first+1)
+	for slot := first; slot <= last; slot++ {
+		slots = append(slots, slot)
+	}
+
+	results, err := relay.DeliveredBidTraces(ctx, slots)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to obtain deliveries for slots %d-%d from %s", first, last, relay.Name()))
+	}
+	for _, slot := range slots {
+		result, exists := results[slot]
+		if !exists {
+			return fmt.Errorf("no result for slot %d from %s", slot, relay.Name())
+		}
+		if result.Err != nil {
+			return errors.Wrap(result.Err, fmt.Sprintf("failed to obtain delivery for slot %d from %s", slot, relay.Name()))
+		}
+		if result.Trace == nil {
+			continue
+		}
+		if err := l.Add(relay.Name(), result.Trace); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to add delivery for slot %d from %s", slot, relay.Name()))
+		}
+	}
+
+	return nil
+}
+
+// populateSlots adds the payloads delivered by the relay for the slots from
+// first to last inclusive, obtained one slot at a time.
+func (l *Leaderboard) populateSlots(ctx context.Context,
+	relay client.DeliveredBidTraceProvider,
+	first phase0.Slot,
+	last phase0.Slot,
+) error {
+	for slot := first; slot <= last; slot++ {
+		trace, err := relay.DeliveredBidTrace(ctx, slot)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to obtain delivery for slot %d from %s", slot, relay.Name()))
+		}
+		if trace == nil {
+			continue
+		}
+		if err := l.Add(relay.Name(), trace); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to add delivery for slot %d from %s", slot, relay.Name()))
+		}
+	}
+
+	return nil
+}
+
+// SlotRange returns the first and last slots with delivered payloads.
+// Both are 0 if the leaderboard is empty.
+func (l *Leaderboard) SlotRange() (phase0.Slot, phase0.Slot) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.firstSlot, l.lastSlot
+}
+
+// Builders returns the statistics for each builder, ordered by blocks won
+// and then total value, highest first.
+func (l *Leaderboard) Builders() []*BuilderStats {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	res := make([]*BuilderStats, 0, len(l.builderValues))
+	for builder, values := range l.builderValues {
+		stats := &BuilderStats{
+			Builder:     builder,
+			BlocksWon:   len(values),
+			TotalValue:  new(big.Int),
+			Share:       float64(len(values)) / float64(len(l.blocks)),
+			RelayShares: make(map[string]float64),
+		}
+		for _, value := range values {
+			stats.TotalValue.Add(stats.TotalValue, value)
+		}
+		stats.MedianValue = median(values)
+		for relay, builders := range l.relayBuilders {
+			if count, exists := builders[builder]; exists {
+				stats.RelayShares[relay] = float64(count) / float64(l.relayDeliveries[relay])
+			}
+		}
+		res = append(res, stats)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].BlocksWon != res[j].BlocksWon {
+			return res[i].BlocksWon > res[j].BlocksWon
+		}
+		if cmp := res[i].TotalValue.Cmp(res[j].TotalValue); cmp != 0 {
+			return cmp > 0
+		}
+		return bytes.Compare(res[i].Builder[:], res[j].Builder[:]) < 0
+	})
+
+	return res
+}
+
+// Relays returns the statistics for each relay, ordered by deliveries,
+// highest first.
+func (l *Leaderboard) Relays() []*RelayStats {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	res := make([]*RelayStats, 0, len(l.relayDeliveries))
+	for relay, deliveries := range l.relayDeliveries {
+		res = append(res, &RelayStats{
+			Relay:      relay,
+			Deliveries: deliveries,
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Deliveries != res[j].Deliveries {
+			return res[i].Deliveries > res[j].Deliveries
+		}
+		return res[i].Relay < res[j].Relay
+	})
+
+	return res
+}
+
+// median returns the median of the values, rounding down.
+func median(values []*big.Int) *big.Int {
+	if len(values) == 0 {
+		return new(big.Int)
+	}
+
+	sorted := make([]*big.Int, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Cmp(sorted[j]) < 0
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return new(big.Int).Set(sorted[mid])
+	}
+	res := new(big.Int).Add(sorted[mid-1], sorted[mid])
+
+	return res.Rsh(res, 1)
+}
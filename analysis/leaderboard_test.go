@@ -0,0 +1,211 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	"github.com/attestantio/go-relay-client/analysis"
+	"github.com/attestantio/go-relay-client/api"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func delivery(slot phase0.Slot, builder byte, block byte, value int64) *v1.BidTrace {
+	return &v1.BidTrace{
+		Slot:          slot,
+		BuilderPubkey: phase0.BLSPubKey{builder},
+		BlockHash:     phase0.Hash32{block},
+		Value:         big.NewInt(value),
+	}
+}
+
+// deliveryRelay is a mock relay that provides delivered payloads.
+type deliveryRelay struct {
+	name       string
+	deliveries map[phase0.Slot]*v1.BidTrace
+	err        error
+}
+
+func (r *deliveryRelay) Name() string              { return r.name }
+func (r *deliveryRelay) Address() string           { return r.name }
+func (r *deliveryRelay) Pubkey() *phase0.BLSPubKey { return nil }
+
+func (r *deliveryRelay) DeliveredBidTrace(_ context.Context, slot phase0.Slot) (*v1.BidTrace, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.deliveries[slot], nil
+}
+
+// batchRelay is a mock relay that provides delivered payloads for many slots.
+type batchRelay struct {
+	deliveryRelay
+	slotErrs map[phase0.Slot]error
+
+	requests int
+}
+
+func (r *batchRelay) DeliveredBidTrace(_ context.Context, _ phase0.Slot) (*v1.BidTrace, error) {
+	return nil, errors.New("batch relay queried for a single slot")
+}
+
+func (r *batchRelay) DeliveredBidTraces(_ context.Context, slots []phase0.Slot) (map[phase0.Slot]*api.DeliveredBidTraceResult, error) {
+	r.requests++
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	res := make(map[phase0.Slot]*api.DeliveredBidTraceResult, len(slots))
+	for _, slot := range slots {
+		res[slot] = &api.DeliveredBidTraceResult{
+			Trace: r.deliveries[slot],
+			Err:   r.slotErrs[slot],
+		}
+	}
+
+	return res, nil
+}
+
+func TestLeaderboard(t *testing.T) {
+	l := analysis.NewLeaderboard()
+	require.Empty(t, l.Builders())
+	require.Empty(t, l.Relays())
+
+	require.EqualError(t, l.Add("relay1", nil), "no trace supplied")
+	require.EqualError(t, l.Add("relay1", &v1.BidTrace{}), "trace 0x0000000000000000000000000000000000000000000000000000000000000000 has no value")
+
+	// Builder 1 wins slots 10 and 12, builder 2 wins slot 11.
+	// Slot 10 is delivered by both relays.
+	require.NoError(t, l.Add("relay1", delivery(10, 0x01, 0x0a, 100)))
+	require.NoError(t, l.Add("relay2", delivery(10, 0x01, 0x0a, 100)))
+	require.NoError(t, l.Add("relay2", delivery(11, 0x02, 0x0b, 500)))
+	require.NoError(t, l.Add("relay1", delivery(12, 0x01, 0x0c, 301)))
+	// Duplicates are ignored.
+	require.NoError(t, l.Add("relay1", delivery(12, 0x01, 0x0c, 301)))
+
+	first, last := l.SlotRange()
+	require.Equal(t, phase0.Slot(10), first)
+	require.Equal(t, phase0.Slot(12), last)
+
+	builders := l.Builders()
+	require.Len(t, builders, 2)
+	require.Equal(t, phase0.BLSPubKey{0x01}, builders[0].Builder)
+	require.Equal(t, 2, builders[0].BlocksWon)
+	require.Equal(t, big.NewInt(401), builders[0].TotalValue)
+	require.Equal(t, big.NewInt(200), builders[0].MedianValue)
+	require.InDelta(t, 2.0/3.0, builders[0].Share, 1e-9)
+	require.Equal(t, map[string]float64{"relay1": 1, "relay2": 0.5}, builders[0].RelayShares)
+	require.Equal(t, phase0.BLSPubKey{0x02}, builders[1].Builder)
+	require.Equal(t, big.NewInt(500), builders[1].MedianValue)
+	require.Equal(t, map[string]float64{"relay2": 0.5}, builders[1].RelayShares)
+
+	require.Equal(t, []*analysis.RelayStats{
+		{Relay: "relay1", Deliveries: 2},
+		{Relay: "relay2", Deliveries: 2},
+	}, l.Relays())
+
+	// Incremental update.
+	require.NoError(t, l.Add("relay1", delivery(13, 0x02, 0x0d, 700)))
+	builders = l.Builders()
+	require.Equal(t, phase0.BLSPubKey{0x02}, builders[0].Builder)
+	require.Equal(t, 2, builders[0].BlocksWon)
+	require.Equal(t, big.NewInt(600), builders[0].MedianValue)
+	require.Equal(t, phase0.BLSPubKey{0x01}, builders[1].Builder)
+	require.Equal(t, "relay1", l.Relays()[0].Relay)
+	require.Equal(t, 3, l.Relays()[0].Deliveries)
+}
+
+func TestLeaderboardPopulate(t *testing.T) {
+	relays := []client.DeliveredBidTraceProvider{
+		&deliveryRelay{
+			name: "relay1",
+			deliveries: map[phase0.Slot]*v1.BidTrace{
+				10: delivery(10, 0x01, 0x0a, 100),
+				12: delivery(12, 0x02, 0x0c, 200),
+			},
+		},
+		&deliveryRelay{
+			name: "relay2",
+			deliveries: map[phase0.Slot]*v1.BidTrace{
+				10: delivery(10, 0x01, 0x0a, 100),
+				11: delivery(11, 0x01, 0x0b, 300),
+			},
+		},
+	}
+
+	l := analysis.NewLeaderboard()
+	require.EqualError(t, l.Populate(context.Background(), relays, 12, 10), "last slot before first slot")
+	require.NoError(t, l.Populate(context.Background(), relays, 10, 12))
+
+	builders := l.Builders()
+	require.Len(t, builders, 2)
+	require.Equal(t, 2, builders[0].BlocksWon)
+	require.Equal(t, big.NewInt(400), builders[0].TotalValue)
+	require.Equal(t, 1, builders[1].BlocksWon)
+
+	failing := append(relays, &deliveryRelay{name: "relay3", err: errors.New("mock error")})
+	require.EqualError(t, analysis.NewLeaderboard().Populate(context.Background(), failing, 10, 12), "failed to obtain delivery for slot 10 from relay3: mock error")
+}
+
+func TestLeaderboardPopulateBatch(t *testing.T) {
+	batch := &batchRelay{
+		deliveryRelay: deliveryRelay{
+			name: "relay1",
+			deliveries: map[phase0.Slot]*v1.BidTrace{
+				10: delivery(10, 0x01, 0x0a, 100),
+				12: delivery(12, 0x02, 0x0c, 200),
+			},
+		},
+	}
+	relays := []client.DeliveredBidTraceProvider{
+		batch,
+		&deliveryRelay{
+			name: "relay2",
+			deliveries: map[phase0.Slot]*v1.BidTrace{
+				10: delivery(10, 0x01, 0x0a, 100),
+				11: delivery(11, 0x01, 0x0b, 300),
+			},
+		},
+	}
+
+	l := analysis.NewLeaderboard()
+	require.NoError(t, l.Populate(context.Background(), relays, 10, 12))
+	require.Equal(t, 1, batch.requests)
+
+	builders := l.Builders()
+	require.Len(t, builders, 2)
+	require.Equal(t, 2, builders[0].BlocksWon)
+	require.Equal(t, big.NewInt(400), builders[0].TotalValue)
+	require.Equal(t, 1, builders[1].BlocksWon)
+	require.Equal(t, []*analysis.RelayStats{
+		{Relay: "relay1", Deliveries: 2},
+		{Relay: "relay2", Deliveries: 2},
+	}, l.Relays())
+
+	failing := &batchRelay{deliveryRelay: deliveryRelay{name: "relay3", err: errors.New("mock error")}}
+	require.EqualError(t, analysis.NewLeaderboard().Populate(context.Background(), []client.DeliveredBidTraceProvider{failing}, 10, 12), "failed to obtain deliveries for slots 10-12 from relay3: mock error")
+
+	failingSlot := &batchRelay{
+		deliveryRelay: deliveryRelay{name: "relay4"},
+		slotErrs:      map[phase0.Slot]error{11: errors.New("mock error")},
+	}
+	require.EqualError(t, analysis.NewLeaderboard().Populate(context.Background(), []client.DeliveredBidTraceProvider{failingSlot}, 10, 12), "failed to obtain delivery for slot 11 from relay4: mock error")
+}
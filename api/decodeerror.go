@@ -0,0 +1,46 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DecodeError is the error for a record in a response that could not be
+// decoded.
+type DecodeError struct {
+	// Index is the index of the record in the response.
+	Index int
+	// Field is the JSON name of the field that failed, if known.  Fields of
+	// nested objects are separated by dots.
+	Field string
+	// Data is the raw JSON of the record, if available.
+	Data json.RawMessage
+	// Err is the reason for the failure.
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("record %d: %v", e.Index, e.Err)
+	}
+
+	return fmt.Sprintf("record %d field %s: %v", e.Index, e.Field, e.Err)
+}
+
+// Unwrap returns the reason for the failure.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,30 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api contains the types returned by client operations, as opposed
+// to the types defined by the relay API itself which are in api/v1.
+package api
+
+import (
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+)
+
+// DeliveredBidTraceResult is the result of obtaining the bid trace of the
+// payload delivered for a single slot.
+type DeliveredBidTraceResult struct {
+	// Trace is the bid trace of the delivered payload.
+	// It is nil if no payload was delivered, or if Err is set.
+	Trace *v1.BidTrace
+	// Err is the error encountered obtaining the bid trace, if any.
+	Err error
+}
@@ -0,0 +1,26 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+)
+
+// InvalidRegistration is a queued proposer whose registration failed verification.
+type InvalidRegistration struct {
+	// Proposer is the queued proposer.
+	Proposer *v1.QueuedProposer
+	// Err is the reason verification failed.
+	Err error
+}
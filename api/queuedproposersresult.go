@@ -0,0 +1,28 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+)
+
+// QueuedProposersResult is the result of obtaining queued proposers, where
+// proposers whose registrations fail verification are separated out.
+type QueuedProposersResult struct {
+	// Proposers are the queued proposers with valid registrations.
+	Proposers []*v1.QueuedProposer
+	// Invalid are the queued proposers whose registrations failed
+	// verification, if any.
+	Invalid []*InvalidRegistration
+}
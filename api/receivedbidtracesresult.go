@@ -0,0 +1,27 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+)
+
+// ReceivedBidTracesResult is the result of obtaining the bid traces received
+// for a single slot, where records that cannot be decoded are skipped.
+type ReceivedBidTracesResult struct {
+	// Traces are the bid traces that were decoded.
+	Traces []*v1.BidTraceWithTimestamp
+	// Errors are the errors for records that could not be decoded, if any.
+	Errors []*DecodeError
+}
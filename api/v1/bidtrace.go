@@ -36,7 +36,8 @@ type BidTrace struct {
 	ProposerFeeRecipient bellatrix.ExecutionAddress
 	GasLimit             uint64
 	GasUsed              uint64
-	Value                *big.Int
+	// Value is required; a bid trace without a value cannot be marshalled.
+	Value *big.Int
 }
 
 // bidTraceJSON is the spec representation of the struct.
@@ -54,6 +55,11 @@ type bidTraceJSON struct {
 
 // MarshalJSON implements json.Marshaler.
 func (b *BidTrace) MarshalJSON() ([]byte, error) {
+	value, err := valueString(b.Value)
+	if err != nil {
+		return nil, err
+	}
+
 	return json.Marshal(&bidTraceJSON{
 		Slot:                 fmt.Sprintf("%d", b.Slot),
 		ParentHash:           fmt.Sprintf("%#x", b.ParentHash),
@@ -63,7 +69,7 @@ func (b *BidTrace) MarshalJSON() ([]byte, error) {
 		ProposerFeeRecipient: fmt.Sprintf("%#x", b.ProposerFeeRecipient),
 		GasLimit:             fmt.Sprintf("%d", b.GasLimit),
 		GasUsed:              fmt.Sprintf("%d", b.GasUsed),
-		Value:                b.Value.String(),
+		Value:                value,
 	})
 }
 
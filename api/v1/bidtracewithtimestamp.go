@@ -37,8 +37,9 @@ type BidTraceWithTimestamp struct {
 	ProposerFeeRecipient bellatrix.ExecutionAddress
 	GasLimit             uint64
 	GasUsed              uint64
-	Value                *big.Int
-	Timestamp            time.Time
+	// Value is required; a bid trace without a value cannot be marshalled.
+	Value     *big.Int
+	Timestamp time.Time
 }
 
 // bidTraceWithTimestampJSON is the spec representation of the struct.
@@ -74,6 +75,11 @@ type oldBidTraceWithTimestampJSON struct {
 
 // MarshalJSON implements json.Marshaler.
 func (b *BidTraceWithTimestamp) MarshalJSON() ([]byte, error) {
+	value, err := valueString(b.Value)
+	if err != nil {
+		return nil, err
+	}
+
 	return json.Marshal(&bidTraceWithTimestampJSON{
 		Slot:                 fmt.Sprintf("%d", b.Slot),
 		ParentHash:           fmt.Sprintf("%#x", b.ParentHash),
@@ -83,7 +89,7 @@ func (b *BidTraceWithTimestamp) MarshalJSON() ([]byte, error) {
 		ProposerFeeRecipient: fmt.Sprintf("%#x", b.ProposerFeeRecipient),
 		GasLimit:             fmt.Sprintf("%d", b.GasLimit),
 		GasUsed:              fmt.Sprintf("%d", b.GasUsed),
-		Value:                b.Value.String(),
+		Value:                value,
 		Timestamp:            fmt.Sprintf("%d", b.Timestamp.Unix()),
 		TimestampMS:          fmt.Sprintf("%d", b.Timestamp.UnixNano()/1e6),
 	})
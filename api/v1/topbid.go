@@ -0,0 +1,242 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// topBidSSZSize is the size of the SSZ representation of a top bid.
+const topBidSSZSize = 8 + 8 + 8 + 32 + 32 + 48 + 20 + 32
+
+// TopBid represents an update to the top bid held by a relay.
+type TopBid struct {
+	Timestamp     time.Time
+	Slot          phase0.Slot
+	BlockNumber   uint64
+	BlockHash     phase0.Hash32
+	ParentHash    phase0.Hash32
+	BuilderPubkey phase0.BLSPubKey
+	FeeRecipient  bellatrix.ExecutionAddress
+	// Value is required; a top bid without a value cannot be marshalled.
+	Value *big.Int
+}
+
+// topBidJSON is the spec representation of the struct.
+// Relays differ in whether they quote integers, so they are held raw.
+type topBidJSON struct {
+	Timestamp     json.RawMessage `json:"timestamp"`
+	Slot          json.RawMessage `json:"slot"`
+	BlockNumber   json.RawMessage `json:"block_number"`
+	BlockHash     string          `json:"block_hash"`
+	ParentHash    string          `json:"parent_hash"`
+	BuilderPubkey string          `json:"builder_pubkey"`
+	FeeRecipient  string          `json:"fee_recipient"`
+	Value         string          `json:"value"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t *TopBid) MarshalJSON() ([]byte, error) {
+	value, err := valueString(t.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&topBidJSON{
+		Timestamp:     json.RawMessage(fmt.Sprintf(`"%d"`, t.Timestamp.UnixMilli())),
+		Slot:          json.RawMessage(fmt.Sprintf(`"%d"`, t.Slot)),
+		BlockNumber:   json.RawMessage(fmt.Sprintf(`"%d"`, t.BlockNumber)),
+		BlockHash:     fmt.Sprintf("%#x", t.BlockHash),
+		ParentHash:    fmt.Sprintf("%#x", t.ParentHash),
+		BuilderPubkey: fmt.Sprintf("%#x", t.BuilderPubkey),
+		FeeRecipient:  fmt.Sprintf("%#x", t.FeeRecipient),
+		Value:         value,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *TopBid) UnmarshalJSON(input []byte) error {
+	var data topBidJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	return t.unpack(&data)
+}
+
+func (t *TopBid) unpack(data *topBidJSON) error {
+	if len(data.Timestamp) == 0 {
+		return errors.New("timestamp missing")
+	}
+	timestamp, err := parseRawUint(data.Timestamp)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for timestamp")
+	}
+	//nolint:gosec
+	t.Timestamp = time.UnixMilli(int64(timestamp))
+
+	if len(data.Slot) == 0 {
+		return errors.New("slot missing")
+	}
+	slot, err := parseRawUint(data.Slot)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	t.Slot = phase0.Slot(slot)
+
+	if len(data.BlockNumber) == 0 {
+		return errors.New("block number missing")
+	}
+	t.BlockNumber, err = parseRawUint(data.BlockNumber)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for block number")
+	}
+
+	if data.BlockHash == "" {
+		return errors.New("block hash missing")
+	}
+	blockHash, err := hex.DecodeString(strings.TrimPrefix(data.BlockHash, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for block hash")
+	}
+	if len(blockHash) != phase0.RootLength {
+		return errors.New("incorrect length for block hash")
+	}
+	copy(t.BlockHash[:], blockHash)
+
+	if data.ParentHash == "" {
+		return errors.New("parent hash missing")
+	}
+	parentHash, err := hex.DecodeString(strings.TrimPrefix(data.ParentHash, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for parent hash")
+	}
+	if len(parentHash) != phase0.RootLength {
+		return errors.New("incorrect length for parent hash")
+	}
+	copy(t.ParentHash[:], parentHash)
+
+	if data.BuilderPubkey == "" {
+		return errors.New("builder pubkey missing")
+	}
+	builderPubkey, err := hex.DecodeString(strings.TrimPrefix(data.BuilderPubkey, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for builder pubkey")
+	}
+	if len(builderPubkey) != phase0.PublicKeyLength {
+		return errors.New("incorrect length for builder pubkey")
+	}
+	copy(t.BuilderPubkey[:], builderPubkey)
+
+	if data.FeeRecipient == "" {
+		return errors.New("fee recipient missing")
+	}
+	feeRecipient, err := hex.DecodeString(strings.TrimPrefix(data.FeeRecipient, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for fee recipient")
+	}
+	if len(feeRecipient) != bellatrix.FeeRecipientLength {
+		return errors.New("incorrect length for fee recipient")
+	}
+	copy(t.FeeRecipient[:], feeRecipient)
+
+	if data.Value == "" {
+		return errors.New("value missing")
+	}
+	value, success := new(big.Int).SetString(data.Value, 10)
+	if !success {
+		return errors.New("value invalid")
+	}
+	t.Value = value
+
+	return nil
+}
+
+// parseRawUint parses an integer that may or may not be quoted.
+func parseRawUint(input json.RawMessage) (uint64, error) {
+	return strconv.ParseUint(strings.Trim(string(input), `"`), 10, 64)
+}
+
+// SizeSSZ returns the size of the SSZ representation of the top bid.
+func (*TopBid) SizeSSZ() int {
+	return topBidSSZSize
+}
+
+// MarshalSSZ marshals the top bid to its SSZ representation.
+func (t *TopBid) MarshalSSZ() ([]byte, error) {
+	if t.Value == nil {
+		return nil, errors.New("value missing")
+	}
+	if t.Value.Sign() < 0 || t.Value.BitLen() > 256 {
+		return nil, errors.New("value out of range")
+	}
+
+	dst := make([]byte, topBidSSZSize)
+	//nolint:gosec
+	binary.LittleEndian.PutUint64(dst[0:8], uint64(t.Timestamp.UnixMilli()))
+	binary.LittleEndian.PutUint64(dst[8:16], uint64(t.Slot))
+	binary.LittleEndian.PutUint64(dst[16:24], t.BlockNumber)
+	copy(dst[24:56], t.BlockHash[:])
+	copy(dst[56:88], t.ParentHash[:])
+	copy(dst[88:136], t.BuilderPubkey[:])
+	copy(dst[136:156], t.FeeRecipient[:])
+	value := t.Value.FillBytes(make([]byte, 32))
+	for i := range value {
+		dst[156+i] = value[31-i]
+	}
+
+	return dst, nil
+}
+
+// UnmarshalSSZ unmarshals the top bid from its SSZ representation.
+func (t *TopBid) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != topBidSSZSize {
+		return fmt.Errorf("incorrect size %d for top bid", len(buf))
+	}
+
+	//nolint:gosec
+	t.Timestamp = time.UnixMilli(int64(binary.LittleEndian.Uint64(buf[0:8])))
+	t.Slot = phase0.Slot(binary.LittleEndian.Uint64(buf[8:16]))
+	t.BlockNumber = binary.LittleEndian.Uint64(buf[16:24])
+	copy(t.BlockHash[:], buf[24:56])
+	copy(t.ParentHash[:], buf[56:88])
+	copy(t.BuilderPubkey[:], buf[88:136])
+	copy(t.FeeRecipient[:], buf[136:156])
+	value := make([]byte, 32)
+	for i := range value {
+		value[i] = buf[187-i]
+	}
+	t.Value = new(big.Int).SetBytes(value)
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (t *TopBid) String() string {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
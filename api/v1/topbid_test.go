@@ -0,0 +1,105 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopBidJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected []byte
+		err      string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.topBidJSON",
+		},
+		{
+			name:  "TimestampMissing",
+			input: []byte(`{"slot":"7654321","block_number":"18500000","block_hash":"0x4c4f7e0a46a4f8b010bc7f899c949b5b9c0c58d510b6a5b46eda48d796a469ed","parent_hash":"0x6cd0618e3e13b751506264263b09979e461e35dec0dfbac20d81ece99a43b9dc","builder_pubkey":"0xa1dead01e65f0a0eee7b5170223f20c8f0cbf122eac3324d61afbdb33a8885ff8cab2ef514ac2c7698ae0d6289ef27fc","fee_recipient":"0x32a6bcae2dd28f85555467d85600f4ecc8172808","value":"34682404831419603"}`),
+			err:   "timestamp missing",
+		},
+		{
+			name:  "SlotInvalid",
+			input: []byte(`{"timestamp":"1700000000123","slot":"-1","block_number":"18500000","block_hash":"0x4c4f7e0a46a4f8b010bc7f899c949b5b9c0c58d510b6a5b46eda48d796a469ed","parent_hash":"0x6cd0618e3e13b751506264263b09979e461e35dec0dfbac20d81ece99a43b9dc","builder_pubkey":"0xa1dead01e65f0a0eee7b5170223f20c8f0cbf122eac3324d61afbdb33a8885ff8cab2ef514ac2c7698ae0d6289ef27fc","fee_recipient":"0x32a6bcae2dd28f85555467d85600f4ecc8172808","value":"34682404831419603"}`),
+			err:   "invalid value for slot: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "BlockHashWrongLength",
+			input: []byte(`{"timestamp":"1700000000123","slot":"7654321","block_number":"18500000","block_hash":"0x4f7e0a46a4f8b010bc7f899c949b5b9c0c58d510b6a5b46eda48d796a469ed","parent_hash":"0x6cd0618e3e13b751506264263b09979e461e35dec0dfbac20d81ece99a43b9dc","builder_pubkey":"0xa1dead01e65f0a0eee7b5170223f20c8f0cbf122eac3324d61afbdb33a8885ff8cab2ef514ac2c7698ae0d6289ef27fc","fee_recipient":"0x32a6bcae2dd28f85555467d85600f4ecc8172808","value":"34682404831419603"}`),
+			err:   "incorrect length for block hash",
+		},
+		{
+			name:  "ValueInvalid",
+			input: []byte(`{"timestamp":"1700000000123","slot":"7654321","block_number":"18500000","block_hash":"0x4c4f7e0a46a4f8b010bc7f899c949b5b9c0c58d510b6a5b46eda48d796a469ed","parent_hash":"0x6cd0618e3e13b751506264263b09979e461e35dec0dfbac20d81ece99a43b9dc","builder_pubkey":"0xa1dead01e65f0a0eee7b5170223f20c8f0cbf122eac3324d61afbdb33a8885ff8cab2ef514ac2c7698ae0d6289ef27fc","fee_recipient":"0x32a6bcae2dd28f85555467d85600f4ecc8172808","value":"invalid"}`),
+			err:   "value invalid",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"timestamp":"1700000000123","slot":"7654321","block_number":"18500000","block_hash":"0x4c4f7e0a46a4f8b010bc7f899c949b5b9c0c58d510b6a5b46eda48d796a469ed","parent_hash":"0x6cd0618e3e13b751506264263b09979e461e35dec0dfbac20d81ece99a43b9dc","builder_pubkey":"0xa1dead01e65f0a0eee7b5170223f20c8f0cbf122eac3324d61afbdb33a8885ff8cab2ef514ac2c7698ae0d6289ef27fc","fee_recipient":"0x32a6bcae2dd28f85555467d85600f4ecc8172808","value":"34682404831419603"}`),
+		},
+		{
+			name:     "Unquoted",
+			input:    []byte(`{"timestamp":1700000000123,"slot":7654321,"block_number":18500000,"block_hash":"0x4c4f7e0a46a4f8b010bc7f899c949b5b9c0c58d510b6a5b46eda48d796a469ed","parent_hash":"0x6cd0618e3e13b751506264263b09979e461e35dec0dfbac20d81ece99a43b9dc","builder_pubkey":"0xa1dead01e65f0a0eee7b5170223f20c8f0cbf122eac3324d61afbdb33a8885ff8cab2ef514ac2c7698ae0d6289ef27fc","fee_recipient":"0x32a6bcae2dd28f85555467d85600f4ecc8172808","value":"34682404831419603"}`),
+			expected: []byte(`{"timestamp":"1700000000123","slot":"7654321","block_number":"18500000","block_hash":"0x4c4f7e0a46a4f8b010bc7f899c949b5b9c0c58d510b6a5b46eda48d796a469ed","parent_hash":"0x6cd0618e3e13b751506264263b09979e461e35dec0dfbac20d81ece99a43b9dc","builder_pubkey":"0xa1dead01e65f0a0eee7b5170223f20c8f0cbf122eac3324d61afbdb33a8885ff8cab2ef514ac2c7698ae0d6289ef27fc","fee_recipient":"0x32a6bcae2dd28f85555467d85600f4ecc8172808","value":"34682404831419603"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res v1.TopBid
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				expected := test.expected
+				if expected == nil {
+					expected = test.input
+				}
+				require.Equal(t, string(expected), string(rt))
+				require.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestTopBidSSZ(t *testing.T) {
+	var bid v1.TopBid
+	require.NoError(t, json.Unmarshal([]byte(`{"timestamp":"1700000000123","slot":"7654321","block_number":"18500000","block_hash":"0x4c4f7e0a46a4f8b010bc7f899c949b5b9c0c58d510b6a5b46eda48d796a469ed","parent_hash":"0x6cd0618e3e13b751506264263b09979e461e35dec0dfbac20d81ece99a43b9dc","builder_pubkey":"0xa1dead01e65f0a0eee7b5170223f20c8f0cbf122eac3324d61afbdb33a8885ff8cab2ef514ac2c7698ae0d6289ef27fc","fee_recipient":"0x32a6bcae2dd28f85555467d85600f4ecc8172808","value":"34682404831419603"}`), &bid))
+
+	data, err := bid.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, bid.SizeSSZ())
+
+	var res v1.TopBid
+	require.NoError(t, res.UnmarshalSSZ(data))
+	require.Equal(t, bid.String(), res.String())
+
+	require.EqualError(t, res.UnmarshalSSZ(data[1:]), "incorrect size 187 for top bid")
+}
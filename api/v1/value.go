@@ -0,0 +1,149 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// valued is implemented by records that have a value.
+type valued interface {
+	// bigValue returns the value of the record, and false if the record is nil.
+	bigValue() (*big.Int, bool)
+}
+
+func (b *BidTrace) bigValue() (*big.Int, bool) {
+	if b == nil {
+		return nil, false
+	}
+
+	return b.Value, true
+}
+
+func (b *BidTraceWithTimestamp) bigValue() (*big.Int, bool) {
+	if b == nil {
+		return nil, false
+	}
+
+	return b.Value, true
+}
+
+// ValueUint256 returns the value of the bid trace as a uint256.
+// An error is returned if the bid trace has no value.
+func (b *BidTrace) ValueUint256() (*uint256.Int, error) {
+	res := new(uint256.Int)
+	if err := setUint256(res, b.Value); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SetValueUint256 sets the value of the bid trace from a uint256.
+// A nil value leaves the bid trace without a value.
+func (b *BidTrace) SetValueUint256(value *uint256.Int) {
+	b.Value = value.ToBig()
+}
+
+// ValueUint256 returns the value of the bid trace as a uint256.
+// An error is returned if the bid trace has no value.
+func (b *BidTraceWithTimestamp) ValueUint256() (*uint256.Int, error) {
+	res := new(uint256.Int)
+	if err := setUint256(res, b.Value); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SetValueUint256 sets the value of the bid trace from a uint256.
+// A nil value leaves the bid trace without a value.
+func (b *BidTraceWithTimestamp) SetValueUint256(value *uint256.Int) {
+	b.Value = value.ToBig()
+}
+
+// AddValue adds the value of the record to sum, returning an error rather
+// than overflowing or if the record has no value.  sum is unchanged if an
+// error is returned.  This allows values to be summed as records are
+// streamed.  A nil record is ignored.
+func AddValue[T valued](sum *uint256.Int, record T) error {
+	bigValue, present := record.bigValue()
+	if !present {
+		return nil
+	}
+	var value uint256.Int
+	if err := setUint256(&value, bigValue); err != nil {
+		return err
+	}
+	var total uint256.Int
+	if _, overflow := total.AddOverflow(sum, &value); overflow {
+		return errors.New("sum of values overflows")
+	}
+	sum.Set(&total)
+
+	return nil
+}
+
+// SumValues returns the sum of the values of the records, returning an
+// error rather than overflowing or if a record has no value.  Nil records
+// are ignored.
+func SumValues[T valued](records []T) (*uint256.Int, error) {
+	sum := new(uint256.Int)
+	var value uint256.Int
+	for i, record := range records {
+		bigValue, present := record.bigValue()
+		if !present {
+			continue
+		}
+		if err := setUint256(&value, bigValue); err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("record %d", i))
+		}
+		if _, overflow := sum.AddOverflow(sum, &value); overflow {
+			return nil, errors.New("sum of values overflows")
+		}
+	}
+
+	return sum, nil
+}
+
+// setUint256 sets res to the value.  A nil value is an error rather than 0,
+// as for marshalling.
+func setUint256(res *uint256.Int, value *big.Int) error {
+	if value == nil {
+		return errors.New("value missing")
+	}
+	if value.Sign() < 0 {
+		return errors.New("value is negative")
+	}
+	if res.SetFromBig(value) {
+		return errors.New("value out of range")
+	}
+
+	return nil
+}
+
+// valueString returns the decimal string of the value.  A nil value is an
+// error rather than 0, so that a missing value is not mistaken for a zero
+// value once marshalled.
+func valueString(value *big.Int) (string, error) {
+	if value == nil {
+		return "", errors.New("value missing")
+	}
+
+	return value.String(), nil
+}
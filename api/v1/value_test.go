@@ -0,0 +1,179 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/holiman/uint256"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestValueUint256(t *testing.T) {
+	overflow := new(big.Int).Lsh(big.NewInt(1), 256)
+
+	tests := []struct {
+		name     string
+		value    *big.Int
+		expected *uint256.Int
+		err      string
+	}{
+		{
+			name: "Nil",
+			err:  "value missing",
+		},
+		{
+			name:     "Good",
+			value:    big.NewInt(34682404831419603),
+			expected: uint256.NewInt(34682404831419603),
+		},
+		{
+			name:     "Max",
+			value:    new(big.Int).Sub(overflow, big.NewInt(1)),
+			expected: new(uint256.Int).SetAllOne(),
+		},
+		{
+			name:  "Negative",
+			value: big.NewInt(-1),
+			err:   "value is negative",
+		},
+		{
+			name:  "Overflow",
+			value: overflow,
+			err:   "value out of range",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			trace := &v1.BidTrace{Value: test.value}
+			res, err := trace.ValueUint256()
+			traceWithTimestamp := &v1.BidTraceWithTimestamp{Value: test.value}
+			resWithTimestamp, errWithTimestamp := traceWithTimestamp.ValueUint256()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.EqualError(t, errWithTimestamp, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res)
+			require.NoError(t, errWithTimestamp)
+			require.Equal(t, test.expected, resWithTimestamp)
+		})
+	}
+}
+
+func TestSetValueUint256(t *testing.T) {
+	trace := &v1.BidTrace{}
+	trace.SetValueUint256(uint256.NewInt(12345))
+	require.Equal(t, big.NewInt(12345), trace.Value)
+
+	trace.SetValueUint256(nil)
+	require.Nil(t, trace.Value)
+
+	traceWithTimestamp := &v1.BidTraceWithTimestamp{}
+	traceWithTimestamp.SetValueUint256(new(uint256.Int).SetAllOne())
+	require.Equal(t, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)), traceWithTimestamp.Value)
+}
+
+func TestSumValues(t *testing.T) {
+	maxValue := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	sum, err := v1.SumValues([]*v1.BidTrace{})
+	require.NoError(t, err)
+	require.True(t, sum.IsZero())
+
+	sum, err = v1.SumValues([]*v1.BidTrace{
+		{Value: big.NewInt(1)},
+		nil,
+		{Value: big.NewInt(2)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint256.NewInt(3), sum)
+
+	_, err = v1.SumValues([]*v1.BidTrace{
+		{Value: big.NewInt(1)},
+		{},
+	})
+	require.EqualError(t, err, "record 1: value missing")
+
+	sum, err = v1.SumValues([]*v1.BidTraceWithTimestamp{
+		{Value: maxValue},
+		{Value: big.NewInt(0)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, new(uint256.Int).SetAllOne(), sum)
+
+	_, err = v1.SumValues([]*v1.BidTraceWithTimestamp{
+		{Value: maxValue},
+		{Value: big.NewInt(1)},
+	})
+	require.EqualError(t, err, "sum of values overflows")
+
+	_, err = v1.SumValues([]*v1.BidTrace{
+		{Value: big.NewInt(1)},
+		{Value: big.NewInt(-1)},
+	})
+	require.EqualError(t, err, "record 1: value is negative")
+}
+
+func TestAddValue(t *testing.T) {
+	sum := uint256.NewInt(1)
+	trace := &v1.BidTrace{Value: big.NewInt(2)}
+	require.NoError(t, v1.AddValue(sum, trace))
+	require.Equal(t, uint256.NewInt(3), sum)
+
+	maxValue := &v1.BidTraceWithTimestamp{Value: new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))}
+	require.EqualError(t, v1.AddValue(sum, maxValue), "sum of values overflows")
+	require.Equal(t, uint256.NewInt(3), sum)
+
+	require.EqualError(t, v1.AddValue(sum, &v1.BidTrace{Value: big.NewInt(-1)}), "value is negative")
+	require.Equal(t, uint256.NewInt(3), sum)
+
+	require.EqualError(t, v1.AddValue(sum, &v1.BidTrace{}), "value missing")
+	require.Equal(t, uint256.NewInt(3), sum)
+
+	require.NoError(t, v1.AddValue(sum, (*v1.BidTrace)(nil)))
+	require.Equal(t, uint256.NewInt(3), sum)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = v1.AddValue(sum, trace)
+	})
+	require.Zero(t, allocs)
+}
+
+func TestNilValueJSON(t *testing.T) {
+	// A missing value must not be marshalled as a zero value.
+	_, err := json.Marshal(&v1.BidTrace{})
+	require.ErrorContains(t, err, "value missing")
+
+	_, err = json.Marshal(&v1.BidTraceWithTimestamp{})
+	require.ErrorContains(t, err, "value missing")
+
+	_, err = json.Marshal(&v1.TopBid{})
+	require.ErrorContains(t, err, "value missing")
+
+	require.Contains(t, (&v1.BidTrace{}).String(), "value missing")
+
+	// A zero value round-trips as zero.
+	data, err := json.Marshal(&v1.BidTrace{Value: big.NewInt(0)})
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"value":"0"`)
+	var trace v1.BidTrace
+	require.NoError(t, json.Unmarshal(data, &trace))
+	require.Equal(t, big.NewInt(0), trace.Value)
+}
@@ -0,0 +1,140 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backfill
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// checkpoint records the progress of a backfill.
+// All slots before next are complete, as are those in done.
+type checkpoint struct {
+	First    phase0.Slot   `json:"first"`
+	Last     phase0.Slot   `json:"last"`
+	Relays   []string      `json:"relays"`
+	Received bool          `json:"received"`
+	Next     phase0.Slot   `json:"next"`
+	Done     []phase0.Slot `json:"done,omitempty"`
+
+	done map[phase0.Slot]bool
+}
+
+// newCheckpoint creates a checkpoint for a backfill with nothing complete.
+func (s *Service) newCheckpoint(first phase0.Slot, last phase0.Slot) *checkpoint {
+	relays := make([]string, len(s.relays))
+	for i, relay := range s.relays {
+		relays[i] = relay.Name()
+	}
+
+	return &checkpoint{
+		First:    first,
+		Last:     last,
+		Relays:   relays,
+		Received: s.receivedBidTraces,
+		Next:     first,
+		done:     make(map[phase0.Slot]bool),
+	}
+}
+
+// loadCheckpoint loads the checkpoint for the backfill, or creates a new one
+// if there is no checkpoint file.
+func (s *Service) loadCheckpoint(first phase0.Slot, last phase0.Slot) (*checkpoint, error) {
+	expected := s.newCheckpoint(first, last)
+	if s.checkpointFile == "" {
+		return expected, nil
+	}
+
+	data, err := os.ReadFile(s.checkpointFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return expected, nil
+		}
+
+		return nil, errors.Wrap(err, "failed to read checkpoint file")
+	}
+
+	var cp checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, errors.Wrap(err, "invalid checkpoint file")
+	}
+	if cp.First != expected.First ||
+		cp.Last != expected.Last ||
+		cp.Received != expected.Received ||
+		!slices.Equal(cp.Relays, expected.Relays) {
+		return nil, errors.New("checkpoint file is for a different backfill")
+	}
+
+	cp.done = make(map[phase0.Slot]bool, len(cp.Done))
+	for _, slot := range cp.Done {
+		cp.done[slot] = true
+	}
+
+	return &cp, nil
+}
+
+// complete marks a slot as complete.
+func (cp *checkpoint) complete(slot phase0.Slot) {
+	cp.done[slot] = true
+	for cp.done[cp.Next] {
+		delete(cp.done, cp.Next)
+		cp.Next++
+	}
+}
+
+// save saves the checkpoint to the given file, atomically replacing any
+// existing checkpoint.
+func (cp *checkpoint) save(path string) error {
+	cp.Done = make([]phase0.Slot, 0, len(cp.done))
+	for slot := range cp.done {
+		cp.Done = append(cp.Done, slot)
+	}
+	slices.Sort(cp.Done)
+
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal checkpoint")
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "failed to create checkpoint file")
+	}
+	defer func() {
+		// No-op once renamed.
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return errors.Wrap(err, "failed to write checkpoint file")
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return errors.Wrap(err, "failed to sync checkpoint file")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "failed to close checkpoint file")
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return errors.Wrap(err, "failed to replace checkpoint file")
+	}
+
+	return nil
+}
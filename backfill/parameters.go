@@ -0,0 +1,170 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backfill
+
+import (
+	"fmt"
+	"time"
+
+	client "github.com/attestantio/go-relay-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel           zerolog.Level
+	relays             []client.DeliveredBidTraceProvider
+	receivedBidTraces  bool
+	sink               Sink
+	concurrency        int
+	rateLimit          float64
+	maxRetries         int
+	retryInterval      time.Duration
+	checkpointFile     string
+	checkpointInterval time.Duration
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithRelays sets the relays from which to backfill.
+func WithRelays(relays []client.DeliveredBidTraceProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.relays = relays
+	})
+}
+
+// WithReceivedBidTraces also backfills received bid traces, in which case
+// all relays must provide them.
+func WithReceivedBidTraces(enabled bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.receivedBidTraces = enabled
+	})
+}
+
+// WithSink sets the sink to which backfilled data is written.
+func WithSink(sink Sink) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.sink = sink
+	})
+}
+
+// WithConcurrency sets the number of slots backfilled concurrently.
+func WithConcurrency(concurrency int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.concurrency = concurrency
+	})
+}
+
+// WithRateLimit sets the maximum number of requests per second sent to
+// each relay.  0 disables rate limiting.
+func WithRateLimit(requestsPerSecond float64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.rateLimit = requestsPerSecond
+	})
+}
+
+// WithMaxRetries sets the number of times a failed request is retried
+// before the backfill stops.
+func WithMaxRetries(retries int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxRetries = retries
+	})
+}
+
+// WithRetryInterval sets the interval before the first retry of a failed
+// request; subsequent retries wait proportionally longer.
+func WithRetryInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retryInterval = interval
+	})
+}
+
+// WithCheckpointFile sets the file in which progress is recorded, allowing
+// an interrupted backfill to resume.  If not set progress is not recorded.
+func WithCheckpointFile(path string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.checkpointFile = path
+	})
+}
+
+// WithCheckpointInterval sets the minimum interval between checkpoints.
+func WithCheckpointInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.checkpointInterval = interval
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:           zerolog.GlobalLevel(),
+		concurrency:        4,
+		rateLimit:          10,
+		maxRetries:         3,
+		retryInterval:      time.Second,
+		checkpointInterval: 10 * time.Second,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if len(parameters.relays) == 0 {
+		return nil, errors.New("no relays specified")
+	}
+	if parameters.receivedBidTraces {
+		for _, relay := range parameters.relays {
+			if _, isProvider := relay.(client.ReceivedBidTracesProvider); !isProvider {
+				return nil, fmt.Errorf("relay %s does not provide received bid traces", relay.Name())
+			}
+		}
+	}
+	if parameters.sink == nil {
+		return nil, errors.New("no sink specified")
+	}
+	if parameters.concurrency < 1 {
+		return nil, errors.New("concurrency must be at least 1")
+	}
+	if parameters.rateLimit < 0 {
+		return nil, errors.New("rate limit cannot be negative")
+	}
+	if parameters.maxRetries < 0 {
+		return nil, errors.New("max retries cannot be negative")
+	}
+	if parameters.retryInterval < 0 {
+		return nil, errors.New("retry interval cannot be negative")
+	}
+	if parameters.checkpointInterval < 0 {
+		return nil, errors.New("checkpoint interval cannot be negative")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,255 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// relayData is the data obtained from a relay for a slot.
+type relayData struct {
+	delivered *v1.BidTrace
+	received  []*v1.BidTraceWithTimestamp
+}
+
+// progress tracks the progress of a backfill.
+type progress struct {
+	mu         sync.Mutex
+	checkpoint *checkpoint
+	saved      time.Time
+	completed  uint64
+}
+
+// Run backfills the slots from first to last inclusive, resuming from the
+// checkpoint file if there is one.  Slots are written to the sink as they
+// complete, so are not in order.  Data for slots completed after the last
+// checkpoint is written again on resumption.
+func (s *Service) Run(ctx context.Context, first phase0.Slot, last phase0.Slot) error {
+	if last < first {
+		return errors.New("last slot before first slot")
+	}
+
+	cp, err := s.loadCheckpoint(first, last)
+	if err != nil {
+		return err
+	}
+	if cp.Next > last {
+		log.Debug().Msg("Backfill already complete")
+		return nil
+	}
+	log.Trace().Uint64("first", uint64(first)).Uint64("last", uint64(last)).Uint64("next", uint64(cp.Next)).Msg("Starting backfill")
+
+	// Slots completed out of order in a previous run are skipped.
+	skip := make(map[phase0.Slot]bool, len(cp.done))
+	for slot := range cp.done {
+		skip[slot] = true
+	}
+
+	progress := &progress{
+		checkpoint: cp,
+		saved:      time.Now(),
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	slots := make(chan phase0.Slot)
+	go func() {
+		defer close(slots)
+		for slot := cp.Next; ; slot++ {
+			if !skip[slot] {
+				select {
+				case slots <- slot:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if slot == last {
+				return
+			}
+		}
+	}()
+
+	var errOnce sync.Once
+	var runErr error
+	var wg sync.WaitGroup
+	for range s.concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for slot := range slots {
+				if err := s.backfillSlot(ctx, progress, slot); err != nil {
+					errOnce.Do(func() {
+						runErr = err
+						cancel()
+					})
+
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Record progress regardless of success, so that a rerun resumes.
+	if err := s.saveProgress(progress); err != nil {
+		if runErr == nil {
+			runErr = err
+		} else {
+			log.Error().Err(err).Msg("Failed to save progress")
+		}
+	}
+	if runErr != nil {
+		return runErr
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	log.Trace().Uint64("slots", progress.completed).Msg("Backfill complete")
+
+	return nil
+}
+
+// backfillSlot obtains the data for a slot from all relays and writes it to the sink.
+func (s *Service) backfillSlot(ctx context.Context, progress *progress, slot phase0.Slot) error {
+	data := make([]*relayData, len(s.relays))
+	errs := make([]error, len(s.relays))
+	var wg sync.WaitGroup
+	for i := range s.relays {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			data[i], errs[i] = s.obtainWithRetries(ctx, i, slot)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	progress.mu.Lock()
+	defer progress.mu.Unlock()
+
+	for i, relay := range s.relays {
+		if data[i].delivered != nil {
+			if err := s.sink.WriteDeliveredBidTrace(relay.Name(), data[i].delivered); err != nil {
+				return errors.Wrap(err, fmt.Sprintf("failed to write delivered bid trace for slot %d", slot))
+			}
+		}
+		if s.receivedBidTraces {
+			if err := s.sink.WriteReceivedBidTraces(relay.Name(), data[i].received); err != nil {
+				return errors.Wrap(err, fmt.Sprintf("failed to write received bid traces for slot %d", slot))
+			}
+		}
+	}
+	progress.checkpoint.complete(slot)
+	progress.completed++
+	log.Trace().Uint64("slot", uint64(slot)).Msg("Slot backfilled")
+
+	if time.Since(progress.saved) >= s.checkpointInterval {
+		if err := s.saveCheckpoint(progress); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// obtainWithRetries obtains the data for a slot from a relay, retrying on failure.
+func (s *Service) obtainWithRetries(ctx context.Context, index int, slot phase0.Slot) (*relayData, error) {
+	relay := s.relays[index]
+	for attempt := 0; ; attempt++ {
+		data, err := s.obtain(ctx, index, slot)
+		if err == nil {
+			return data, nil
+		}
+		if attempt >= s.maxRetries || ctx.Err() != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to obtain data for slot %d from %s", slot, relay.Name()))
+		}
+		log.Debug().Str("relay", relay.Name()).Uint64("slot", uint64(slot)).Int("attempt", attempt+1).Err(err).Msg("Failed to obtain data; retrying")
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), fmt.Sprintf("failed to obtain data for slot %d from %s", slot, relay.Name()))
+		case <-time.After(time.Duration(attempt+1) * s.retryInterval):
+		}
+	}
+}
+
+// obtain obtains the data for a slot from a relay.
+func (s *Service) obtain(ctx context.Context, index int, slot phase0.Slot) (*relayData, error) {
+	relay := s.relays[index]
+	limiter := s.limiters[index]
+
+	if err := limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	delivered, err := relay.DeliveredBidTrace(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	data := &relayData{
+		delivered: delivered,
+	}
+
+	if s.receivedBidTraces {
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		//nolint:forcetypeassert
+		data.received, err = relay.(client.ReceivedBidTracesProvider).ReceivedBidTraces(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// saveProgress saves the checkpoint.
+func (s *Service) saveProgress(progress *progress) error {
+	progress.mu.Lock()
+	defer progress.mu.Unlock()
+
+	return s.saveCheckpoint(progress)
+}
+
+// saveCheckpoint flushes the sink and saves the checkpoint.
+// It must be called with the progress lock held.
+func (s *Service) saveCheckpoint(progress *progress) error {
+	if err := s.sink.Flush(); err != nil {
+		return errors.Wrap(err, "failed to flush sink")
+	}
+	progress.saved = time.Now()
+	if s.checkpointFile == "" {
+		return nil
+	}
+	if err := progress.checkpoint.save(s.checkpointFile); err != nil {
+		return err
+	}
+	log.Trace().Uint64("next", uint64(progress.checkpoint.Next)).Msg("Saved checkpoint")
+
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package backfill fetches historical data from relays for a range of
+// slots, recording its progress so that an interrupted backfill can resume.
+package backfill
+
+import (
+	"context"
+	"time"
+
+	client "github.com/attestantio/go-relay-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	"golang.org/x/time/rate"
+)
+
+// Service is a backfill runner.
+type Service struct {
+	relays             []client.DeliveredBidTraceProvider
+	limiters           []*rate.Limiter
+	receivedBidTraces  bool
+	sink               Sink
+	concurrency        int
+	maxRetries         int
+	retryInterval      time.Duration
+	checkpointFile     string
+	checkpointInterval time.Duration
+}
+
+// log is a service-wide logger.
+var log zerolog.Logger
+
+// New creates a new backfill runner.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "backfill").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	limit := rate.Inf
+	if parameters.rateLimit > 0 {
+		limit = rate.Limit(parameters.rateLimit)
+	}
+	limiters := make([]*rate.Limiter, len(parameters.relays))
+	for i := range limiters {
+		limiters[i] = rate.NewLimiter(limit, 1)
+	}
+
+	return &Service{
+		relays:             parameters.relays,
+		limiters:           limiters,
+		receivedBidTraces:  parameters.receivedBidTraces,
+		sink:               parameters.sink,
+		concurrency:        parameters.concurrency,
+		maxRetries:         parameters.maxRetries,
+		retryInterval:      parameters.retryInterval,
+		checkpointFile:     parameters.checkpointFile,
+		checkpointInterval: parameters.checkpointInterval,
+	}, nil
+}
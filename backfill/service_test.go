@@ -0,0 +1,358 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backfill_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/backfill"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// relay is a mock relay that delivers a payload for even slots.
+type relay struct {
+	name string
+	// failSlot is a slot for which requests fail, if failures is non-zero.
+	failSlot phase0.Slot
+	// failures is the number of times requests for failSlot fail, or -1 to always fail.
+	failures int
+
+	mu       sync.Mutex
+	requests map[phase0.Slot]int
+}
+
+func (r *relay) Name() string              { return r.name }
+func (r *relay) Address() string           { return r.name }
+func (r *relay) Pubkey() *phase0.BLSPubKey { return nil }
+
+func (r *relay) DeliveredBidTrace(_ context.Context, slot phase0.Slot) (*v1.BidTrace, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.requests == nil {
+		r.requests = make(map[phase0.Slot]int)
+	}
+	r.requests[slot]++
+	if slot == r.failSlot && r.failures != 0 {
+		if r.failures > 0 {
+			r.failures--
+		}
+
+		return nil, errors.New("timeout")
+	}
+	if slot%2 != 0 {
+		return nil, nil
+	}
+
+	return &v1.BidTrace{Slot: slot}, nil
+}
+
+func (r *relay) ReceivedBidTraces(_ context.Context, slot phase0.Slot) ([]*v1.BidTraceWithTimestamp, error) {
+	return []*v1.BidTraceWithTimestamp{{Slot: slot}, {Slot: slot}}, nil
+}
+
+func (r *relay) requested() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	total := 0
+	for _, requests := range r.requests {
+		total += requests
+	}
+
+	return total
+}
+
+// deliveredRelay is a mock relay that does not provide received bid traces.
+type deliveredRelay struct {
+	relay
+}
+
+func (r *deliveredRelay) ReceivedBidTraces() {}
+
+// sink is a mock sink that records the slots written.
+type sink struct {
+	delivered map[string][]phase0.Slot
+	received  map[string][]phase0.Slot
+	flushes   int
+}
+
+func newSink() *sink {
+	return &sink{
+		delivered: make(map[string][]phase0.Slot),
+		received:  make(map[string][]phase0.Slot),
+	}
+}
+
+func (s *sink) WriteDeliveredBidTrace(relay string, trace *v1.BidTrace) error {
+	s.delivered[relay] = append(s.delivered[relay], trace.Slot)
+	return nil
+}
+
+func (s *sink) WriteReceivedBidTraces(relay string, traces []*v1.BidTraceWithTimestamp) error {
+	for _, trace := range traces {
+		s.received[relay] = append(s.received[relay], trace.Slot)
+	}
+
+	return nil
+}
+
+func (s *sink) Flush() error {
+	s.flushes++
+	return nil
+}
+
+func sorted(slots []phase0.Slot) []phase0.Slot {
+	res := append([]phase0.Slot{}, slots...)
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+
+	return res
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	relays := []client.DeliveredBidTraceProvider{&relay{name: "a"}}
+
+	tests := []struct {
+		name   string
+		params []backfill.Parameter
+		err    string
+	}{
+		{
+			name:   "RelaysMissing",
+			params: []backfill.Parameter{backfill.WithSink(newSink())},
+			err:    "problem with parameters: no relays specified",
+		},
+		{
+			name: "ReceivedNotProvided",
+			params: []backfill.Parameter{
+				backfill.WithRelays([]client.DeliveredBidTraceProvider{&deliveredRelay{relay{name: "b"}}}),
+				backfill.WithReceivedBidTraces(true),
+				backfill.WithSink(newSink()),
+			},
+			err: "problem with parameters: relay b does not provide received bid traces",
+		},
+		{
+			name:   "SinkMissing",
+			params: []backfill.Parameter{backfill.WithRelays(relays)},
+			err:    "problem with parameters: no sink specified",
+		},
+		{
+			name: "ConcurrencyZero",
+			params: []backfill.Parameter{
+				backfill.WithRelays(relays),
+				backfill.WithSink(newSink()),
+				backfill.WithConcurrency(0),
+			},
+			err: "problem with parameters: concurrency must be at least 1",
+		},
+		{
+			name: "RateLimitNegative",
+			params: []backfill.Parameter{
+				backfill.WithRelays(relays),
+				backfill.WithSink(newSink()),
+				backfill.WithRateLimit(-1),
+			},
+			err: "problem with parameters: rate limit cannot be negative",
+		},
+		{
+			name: "MaxRetriesNegative",
+			params: []backfill.Parameter{
+				backfill.WithRelays(relays),
+				backfill.WithSink(newSink()),
+				backfill.WithMaxRetries(-1),
+			},
+			err: "problem with parameters: max retries cannot be negative",
+		},
+		{
+			name: "Good",
+			params: []backfill.Parameter{
+				backfill.WithRelays(relays),
+				backfill.WithSink(newSink()),
+				backfill.WithReceivedBidTraces(true),
+				backfill.WithConcurrency(8),
+				backfill.WithRateLimit(0),
+				backfill.WithMaxRetries(0),
+				backfill.WithRetryInterval(time.Millisecond),
+				backfill.WithCheckpointFile(filepath.Join(t.TempDir(), "checkpoint.json")),
+				backfill.WithCheckpointInterval(0),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := backfill.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+
+	relayA := &relay{name: "a"}
+	// Relay b fails twice for slot 5, so needs retries.
+	relayB := &relay{name: "b", failSlot: 5, failures: 2}
+	data := newSink()
+	s, err := backfill.New(ctx,
+		backfill.WithRelays([]client.DeliveredBidTraceProvider{relayA, relayB}),
+		backfill.WithReceivedBidTraces(true),
+		backfill.WithSink(data),
+		backfill.WithRateLimit(0),
+		backfill.WithRetryInterval(time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	require.EqualError(t, s.Run(ctx, 10, 9), "last slot before first slot")
+
+	require.NoError(t, s.Run(ctx, 1, 10))
+	require.Equal(t, []phase0.Slot{2, 4, 6, 8, 10}, sorted(data.delivered["a"]))
+	require.Equal(t, []phase0.Slot{2, 4, 6, 8, 10}, sorted(data.delivered["b"]))
+	require.Len(t, data.received["a"], 20)
+	require.Equal(t, 10, relayA.requested())
+	require.Equal(t, 12, relayB.requested())
+	require.Equal(t, 1, data.flushes)
+}
+
+func TestResume(t *testing.T) {
+	ctx := context.Background()
+	checkpointFile := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	failing := &relay{name: "a", failSlot: 7, failures: -1}
+	data := newSink()
+	s, err := backfill.New(ctx,
+		backfill.WithRelays([]client.DeliveredBidTraceProvider{failing}),
+		backfill.WithSink(data),
+		backfill.WithConcurrency(1),
+		backfill.WithRateLimit(0),
+		backfill.WithMaxRetries(1),
+		backfill.WithRetryInterval(time.Millisecond),
+		backfill.WithCheckpointFile(checkpointFile),
+		backfill.WithCheckpointInterval(0),
+	)
+	require.NoError(t, err)
+	require.EqualError(t, s.Run(ctx, 1, 20), "failed to obtain data for slot 7 from a: timeout")
+	require.Equal(t, []phase0.Slot{2, 4, 6}, sorted(data.delivered["a"]))
+
+	checkpoint, err := os.ReadFile(checkpointFile)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"first":"1","last":"20","relays":["a"],"received":false,"next":"7"}`, string(checkpoint))
+
+	// A different backfill cannot use the checkpoint.
+	require.EqualError(t, s.Run(ctx, 1, 30), "checkpoint file is for a different backfill")
+
+	// Resume once the relay recovers.
+	failing.mu.Lock()
+	failing.failures = 0
+	failing.requests = nil
+	failing.mu.Unlock()
+	require.NoError(t, s.Run(ctx, 1, 20))
+	require.Equal(t, []phase0.Slot{2, 4, 6, 8, 10, 12, 14, 16, 18, 20}, sorted(data.delivered["a"]))
+	require.Equal(t, 14, failing.requested())
+
+	// A completed backfill does nothing.
+	require.NoError(t, s.Run(ctx, 1, 20))
+	require.Equal(t, 14, failing.requested())
+}
+
+func TestResumeOutOfOrder(t *testing.T) {
+	ctx := context.Background()
+	checkpointFile := filepath.Join(t.TempDir(), "checkpoint.json")
+	require.NoError(t, os.WriteFile(checkpointFile, []byte(`{"first":"1","last":"10","relays":["a"],"received":false,"next":"3","done":["5","6"]}`), 0o600))
+
+	underlying := &relay{name: "a"}
+	data := newSink()
+	s, err := backfill.New(ctx,
+		backfill.WithRelays([]client.DeliveredBidTraceProvider{underlying}),
+		backfill.WithSink(data),
+		backfill.WithRateLimit(0),
+		backfill.WithCheckpointFile(checkpointFile),
+	)
+	require.NoError(t, err)
+	require.NoError(t, s.Run(ctx, 1, 10))
+	require.Equal(t, []phase0.Slot{4, 8, 10}, sorted(data.delivered["a"]))
+	require.Equal(t, 6, underlying.requested())
+
+	checkpoint, err := os.ReadFile(checkpointFile)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"first":"1","last":"10","relays":["a"],"received":false,"next":"11"}`, string(checkpoint))
+}
+
+func TestRateLimit(t *testing.T) {
+	ctx := context.Background()
+
+	underlying := &relay{name: "a"}
+	s, err := backfill.New(ctx,
+		backfill.WithRelays([]client.DeliveredBidTraceProvider{underlying}),
+		backfill.WithSink(newSink()),
+		backfill.WithConcurrency(10),
+		backfill.WithRateLimit(50),
+	)
+	require.NoError(t, err)
+
+	started := time.Now()
+	require.NoError(t, s.Run(ctx, 1, 11))
+	// The first request is immediate, the remaining 10 at 20ms intervals.
+	require.GreaterOrEqual(t, time.Since(started), 180*time.Millisecond)
+	require.Equal(t, 11, underlying.requested())
+}
+
+func TestExportSink(t *testing.T) {
+	delivered := &recordWriter[*v1.BidTrace]{}
+	received := &recordWriter[*v1.BidTraceWithTimestamp]{}
+	exportSink := backfill.NewExportSink(
+		map[string]backfill.RecordWriter[*v1.BidTrace]{"a": delivered},
+		map[string]backfill.RecordWriter[*v1.BidTraceWithTimestamp]{"a": received},
+	)
+
+	require.NoError(t, exportSink.WriteDeliveredBidTrace("a", &v1.BidTrace{}))
+	require.EqualError(t, exportSink.WriteDeliveredBidTrace("b", &v1.BidTrace{}), "no delivered bid trace writer for relay b")
+	require.NoError(t, exportSink.WriteReceivedBidTraces("a", []*v1.BidTraceWithTimestamp{{}, {}}))
+	require.EqualError(t, exportSink.WriteReceivedBidTraces("b", nil), "no received bid traces writer for relay b")
+	require.NoError(t, exportSink.Flush())
+
+	require.Equal(t, 1, delivered.written)
+	require.Equal(t, 1, delivered.flushes)
+	require.Equal(t, 2, received.written)
+	require.Equal(t, 1, received.flushes)
+}
+
+// recordWriter is a mock record writer.
+type recordWriter[T any] struct {
+	written int
+	flushes int
+}
+
+func (w *recordWriter[T]) Write(_ T) error {
+	w.written++
+	return nil
+}
+
+func (w *recordWriter[T]) Flush() error {
+	w.flushes++
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backfill
+
+import (
+	"fmt"
+
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// Sink receives backfilled data.
+// Calls are made by one goroutine at a time.
+type Sink interface {
+	// WriteDeliveredBidTrace writes the bid trace of a payload delivered by a relay.
+	WriteDeliveredBidTrace(relay string, trace *v1.BidTrace) error
+
+	// WriteReceivedBidTraces writes the bid traces received by a relay for a slot.
+	WriteReceivedBidTraces(relay string, traces []*v1.BidTraceWithTimestamp) error
+
+	// Flush ensures that all data written so far is persisted.
+	// It is called before each checkpoint.
+	Flush() error
+}
+
+// RecordWriter is the interface for writing records, as implemented by the
+// writers in the export package.
+type RecordWriter[T any] interface {
+	Write(record T) error
+	Flush() error
+}
+
+// ExportSink is a sink that writes to a set of record writers per relay.
+type ExportSink struct {
+	delivered map[string]RecordWriter[*v1.BidTrace]
+	received  map[string]RecordWriter[*v1.BidTraceWithTimestamp]
+}
+
+// NewExportSink creates a sink that writes to record writers, keyed by relay
+// name.  received can be nil if received bid traces are not backfilled.
+func NewExportSink(delivered map[string]RecordWriter[*v1.BidTrace],
+	received map[string]RecordWriter[*v1.BidTraceWithTimestamp],
+) *ExportSink {
+	return &ExportSink{
+		delivered: delivered,
+		received:  received,
+	}
+}
+
+// WriteDeliveredBidTrace writes the bid trace of a payload delivered by a relay.
+func (s *ExportSink) WriteDeliveredBidTrace(relay string, trace *v1.BidTrace) error {
+	writer, exists := s.delivered[relay]
+	if !exists {
+		return fmt.Errorf("no delivered bid trace writer for relay %s", relay)
+	}
+
+	return writer.Write(trace)
+}
+
+// WriteReceivedBidTraces writes the bid traces received by a relay for a slot.
+func (s *ExportSink) WriteReceivedBidTraces(relay string, traces []*v1.BidTraceWithTimestamp) error {
+	writer, exists := s.received[relay]
+	if !exists {
+		return fmt.Errorf("no received bid traces writer for relay %s", relay)
+	}
+	for _, trace := range traces {
+		if err := writer.Write(trace); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Flush flushes all writers.
+func (s *ExportSink) Flush() error {
+	for relay, writer := range s.delivered {
+		if err := writer.Flush(); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to flush delivered bid traces for %s", relay))
+		}
+	}
+	for relay, writer := range s.received {
+		if err := writer.Flush(); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to flush received bid traces for %s", relay))
+		}
+	}
+
+	return nil
+}
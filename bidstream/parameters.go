@@ -0,0 +1,119 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bidstream
+
+import (
+	"time"
+
+	client "github.com/attestantio/go-relay-client"
+	"github.com/attestantio/go-relay-client/chaintime"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel     zerolog.Level
+	relay        client.ReceivedBidTracesProvider
+	chainTime    *chaintime.Service
+	pollInterval time.Duration
+	gracePeriod  time.Duration
+	bufferSize   int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithRelay sets the relay from which to stream bids.
+func WithRelay(relay client.ReceivedBidTracesProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.relay = relay
+	})
+}
+
+// WithChainTime sets the chain time service used to find the current slot.
+func WithChainTime(service *chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = service
+	})
+}
+
+// WithPollInterval sets the interval between polls of the relay.
+func WithPollInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.pollInterval = interval
+	})
+}
+
+// WithGracePeriod sets the period after the end of the slot for which the
+// relay continues to be polled, to pick up bids that arrive late.
+func WithGracePeriod(period time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.gracePeriod = period
+	})
+}
+
+// WithBufferSize sets the size of the buffer of the bids channel.
+func WithBufferSize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.bufferSize = size
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:     zerolog.GlobalLevel(),
+		pollInterval: 500 * time.Millisecond,
+		gracePeriod:  2 * time.Second,
+		bufferSize:   256,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.relay == nil {
+		return nil, errors.New("no relay specified")
+	}
+	if parameters.chainTime == nil {
+		return nil, errors.New("no chain time specified")
+	}
+	if parameters.pollInterval <= 0 {
+		return nil, errors.New("poll interval must be positive")
+	}
+	if parameters.gracePeriod < 0 {
+		return nil, errors.New("grace period cannot be negative")
+	}
+	if parameters.bufferSize < 0 {
+		return nil, errors.New("buffer size cannot be negative")
+	}
+
+	return &parameters, nil
+}
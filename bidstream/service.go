@@ -0,0 +1,60 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bidstream streams the bids received by a relay as they arrive.
+package bidstream
+
+import (
+	"context"
+	"time"
+
+	client "github.com/attestantio/go-relay-client"
+	"github.com/attestantio/go-relay-client/chaintime"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service streams received bids from a relay.
+type Service struct {
+	relay        client.ReceivedBidTracesProvider
+	chainTime    *chaintime.Service
+	pollInterval time.Duration
+	gracePeriod  time.Duration
+	bufferSize   int
+}
+
+// log is a service-wide logger.
+var log zerolog.Logger
+
+// New creates a new bid stream service.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "bidstream").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		relay:        parameters.relay,
+		chainTime:    parameters.chainTime,
+		pollInterval: parameters.pollInterval,
+		gracePeriod:  parameters.gracePeriod,
+		bufferSize:   parameters.bufferSize,
+	}, nil
+}
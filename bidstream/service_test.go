@@ -0,0 +1,280 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bidstream_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/bidstream"
+	"github.com/attestantio/go-relay-client/chaintime"
+	"github.com/stretchr/testify/require"
+)
+
+// relay is a mock relay that reveals one more bid on each poll.
+type relay struct {
+	bids []*v1.BidTraceWithTimestamp
+
+	mu    sync.Mutex
+	polls int
+}
+
+func (r *relay) Name() string              { return "relay" }
+func (r *relay) Address() string           { return "relay" }
+func (r *relay) Pubkey() *phase0.BLSPubKey { return nil }
+
+func (r *relay) ReceivedBidTraces(_ context.Context, _ phase0.Slot) ([]*v1.BidTraceWithTimestamp, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.reveal(), nil
+}
+
+// reveal returns the bids revealed by the next poll.
+func (r *relay) reveal() []*v1.BidTraceWithTimestamp {
+	r.polls++
+	revealed := r.polls
+	if revealed > len(r.bids) {
+		revealed = len(r.bids)
+	}
+
+	// Return newest first, as relays do.
+	res := make([]*v1.BidTraceWithTimestamp, 0, revealed)
+	for i := revealed - 1; i >= 0; i-- {
+		res = append(res, r.bids[i])
+	}
+
+	return res
+}
+
+// queryRelay is a mock relay that can be queried, optionally applying the
+// minimum timestamp of the query.
+type queryRelay struct {
+	relay
+	honoursMinTimestamp bool
+
+	fullPolls    int
+	queries      int
+	queriedSince []string
+}
+
+func (r *queryRelay) ReceivedBidTraces(_ context.Context, _ phase0.Slot) ([]*v1.BidTraceWithTimestamp, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fullPolls++
+
+	return r.reveal(), nil
+}
+
+func (r *queryRelay) QueryReceivedBidTraces(_ context.Context, query url.Values) ([]*v1.BidTraceWithTimestamp, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.queries++
+	r.queriedSince = append(r.queriedSince, query.Get("min_timestamp_ms"))
+
+	bids := r.reveal()
+	if !r.honoursMinTimestamp {
+		return bids, nil
+	}
+	minTimestampMs, err := strconv.ParseInt(query.Get("min_timestamp_ms"), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*v1.BidTraceWithTimestamp, 0, len(bids))
+	for _, bid := range bids {
+		if bid.Timestamp.UnixMilli() >= minTimestampMs {
+			res = append(res, bid)
+		}
+	}
+
+	return res, nil
+}
+
+func testChainTime(t *testing.T, slotDuration time.Duration) *chaintime.Service {
+	t.Helper()
+
+	chainTime, err := chaintime.New(context.Background(),
+		chaintime.WithGenesisTime(time.Now().Add(-10*slotDuration)),
+		chaintime.WithSlotDuration(slotDuration),
+		chaintime.WithSlotsPerEpoch(32),
+	)
+	require.NoError(t, err)
+
+	return chainTime
+}
+
+func TestNew(t *testing.T) {
+	chainTime := testChainTime(t, time.Second)
+
+	_, err := bidstream.New(context.Background(),
+		bidstream.WithChainTime(chainTime),
+	)
+	require.EqualError(t, err, "problem with parameters: no relay specified")
+
+	_, err = bidstream.New(context.Background(),
+		bidstream.WithRelay(&relay{}),
+	)
+	require.EqualError(t, err, "problem with parameters: no chain time specified")
+
+	_, err = bidstream.New(context.Background(),
+		bidstream.WithRelay(&relay{}),
+		bidstream.WithChainTime(chainTime),
+		bidstream.WithPollInterval(0),
+	)
+	require.EqualError(t, err, "problem with parameters: poll interval must be positive")
+}
+
+func TestSubscribe(t *testing.T) {
+	chainTime := testChainTime(t, 300*time.Millisecond)
+	slot := chainTime.CurrentSlot()
+	start := chainTime.StartOfSlot(slot)
+
+	bids := []*v1.BidTraceWithTimestamp{
+		{Slot: slot, BlockHash: phase0.Hash32{0x01}, Timestamp: start.Add(-100 * time.Millisecond)},
+		{Slot: slot, BlockHash: phase0.Hash32{0x02}, Timestamp: start.Add(-50 * time.Millisecond)},
+		// Resubmission of the same block.
+		{Slot: slot, BlockHash: phase0.Hash32{0x02}, Timestamp: start.Add(-40 * time.Millisecond)},
+		// Bid for another slot.
+		{Slot: slot + 1, BlockHash: phase0.Hash32{0x03}, Timestamp: start},
+		{Slot: slot, BlockHash: phase0.Hash32{0x04}, Timestamp: start.Add(10 * time.Millisecond)},
+	}
+
+	s, err := bidstream.New(context.Background(),
+		bidstream.WithRelay(&relay{bids: bids}),
+		bidstream.WithChainTime(chainTime),
+		bidstream.WithPollInterval(10*time.Millisecond),
+		bidstream.WithGracePeriod(20*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	received := make([]*v1.BidTraceWithTimestamp, 0)
+	timeout := time.After(2 * time.Second)
+	ch := s.Subscribe(context.Background())
+	for done := false; !done; {
+		select {
+		case bid, ok := <-ch:
+			if !ok {
+				done = true
+				break
+			}
+			received = append(received, bid)
+		case <-timeout:
+			require.FailNow(t, "timed out waiting for stream to finish")
+		}
+	}
+
+	require.Equal(t, []*v1.BidTraceWithTimestamp{bids[0], bids[1], bids[2], bids[4]}, received)
+	require.False(t, time.Now().Before(chainTime.StartOfSlot(slot+1)))
+}
+
+func TestSubscribeCancel(t *testing.T) {
+	chainTime := testChainTime(t, time.Minute)
+
+	s, err := bidstream.New(context.Background(),
+		bidstream.WithRelay(&relay{}),
+		bidstream.WithChainTime(chainTime),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := s.Subscribe(ctx)
+	cancel()
+
+	select {
+	case _, ok := <-ch:
+		require.False(t, ok)
+	case <-time.After(2 * time.Second):
+		require.FailNow(t, "timed out waiting for stream to close")
+	}
+}
+
+func TestSubscribeIncremental(t *testing.T) {
+	tests := []struct {
+		name                string
+		honoursMinTimestamp bool
+	}{
+		{
+			name:                "Honoured",
+			honoursMinTimestamp: true,
+		},
+		{
+			name: "Ignored",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			chainTime := testChainTime(t, 300*time.Millisecond)
+			slot := chainTime.CurrentSlot()
+			start := chainTime.StartOfSlot(slot)
+
+			bids := []*v1.BidTraceWithTimestamp{
+				{Slot: slot, BlockHash: phase0.Hash32{0x01}, Timestamp: start.Add(-100 * time.Millisecond)},
+				{Slot: slot, BlockHash: phase0.Hash32{0x02}, Timestamp: start.Add(-50 * time.Millisecond)},
+				{Slot: slot, BlockHash: phase0.Hash32{0x03}, Timestamp: start.Add(10 * time.Millisecond)},
+			}
+			r := &queryRelay{
+				relay:               relay{bids: bids},
+				honoursMinTimestamp: test.honoursMinTimestamp,
+			}
+
+			s, err := bidstream.New(context.Background(),
+				bidstream.WithRelay(r),
+				bidstream.WithChainTime(chainTime),
+				bidstream.WithPollInterval(10*time.Millisecond),
+				bidstream.WithGracePeriod(20*time.Millisecond),
+			)
+			require.NoError(t, err)
+
+			received := make([]*v1.BidTraceWithTimestamp, 0)
+			timeout := time.After(2 * time.Second)
+			ch := s.Subscribe(context.Background())
+			for done := false; !done; {
+				select {
+				case bid, ok := <-ch:
+					if !ok {
+						done = true
+						break
+					}
+					received = append(received, bid)
+				case <-timeout:
+					require.FailNow(t, "timed out waiting for stream to finish")
+				}
+			}
+			require.Equal(t, bids, received)
+
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			if test.honoursMinTimestamp {
+				// Only the first poll obtains all bids.
+				require.Equal(t, 1, r.fullPolls)
+				require.Greater(t, r.queries, 2)
+				require.Equal(t, fmt.Sprintf("%d", bids[0].Timestamp.UnixMilli()), r.queriedSince[0])
+				require.Equal(t, fmt.Sprintf("%d", bids[2].Timestamp.UnixMilli()), r.queriedSince[len(r.queriedSince)-1])
+			} else {
+				// The relay is queried until it returns a bid received
+				// before the minimum timestamp, then polled for all bids.
+				require.Equal(t, 2, r.queries)
+				require.Greater(t, r.fullPolls, 2)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bidstream
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+)
+
+// minTimestampParameter is the query parameter that asks the relay for only
+// the bids received at or after the given time, in milliseconds.
+const minTimestampParameter = "min_timestamp_ms"
+
+// bidKey identifies a bid.  Builders can resubmit the same block, so the
+// timestamp is required alongside the block hash to tell submissions apart.
+type bidKey struct {
+	blockHash   phase0.Hash32
+	timestampMs int64
+}
+
+// Subscribe streams the bids received by the relay for the current slot.
+// Each bid is sent on the returned channel once, in order of receipt within
+// each poll.  The channel is closed after the end of the slot plus the grace
+// period, or when the context is done.
+//
+// If the relay can be queried, polls after the first ask it only for bids
+// received since the latest bid seen.  Relays that ignore or reject the
+// request are polled for all bids in the slot for the rest of the stream.
+func (s *Service) Subscribe(ctx context.Context) <-chan *v1.BidTraceWithTimestamp {
+	slot := s.chainTime.CurrentSlot()
+	deadline := s.chainTime.StartOfSlot(slot + 1).Add(s.gracePeriod)
+
+	bids := make(chan *v1.BidTraceWithTimestamp, s.bufferSize)
+	go s.stream(ctx, slot, deadline, bids)
+
+	return bids
+}
+
+// stream polls the relay for the slot until the deadline, sending new bids.
+func (s *Service) stream(ctx context.Context,
+	slot phase0.Slot,
+	deadline time.Time,
+	bids chan<- *v1.BidTraceWithTimestamp,
+) {
+	defer close(bids)
+	log := log.With().Str("relay", s.relay.Name()).Uint64("slot", uint64(slot)).Logger()
+
+	queryProvider, incremental := s.relay.(client.ReceivedBidTracesQueryProvider)
+	seen := make(map[bidKey]struct{})
+	var latestMs int64
+	for {
+		// Note the time before polling, so that bids received during the
+		// poll are still picked up if it finishes after the deadline.
+		final := !time.Now().Before(deadline)
+
+		var traces []*v1.BidTraceWithTimestamp
+		var err error
+		if incremental && len(seen) > 0 {
+			traces, err = queryProvider.QueryReceivedBidTraces(ctx, url.Values{
+				"slot":                []string{fmt.Sprintf("%d", slot)},
+				minTimestampParameter: []string{fmt.Sprintf("%d", latestMs)},
+			})
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil || !honoursMinTimestamp(traces, latestMs) {
+				log.Debug().Err(err).Msg("Relay does not support incremental queries; obtaining all bids")
+				incremental = false
+				traces, err = s.relay.ReceivedBidTraces(ctx, slot)
+			}
+		} else {
+			traces, err = s.relay.ReceivedBidTraces(ctx, slot)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to obtain received bid traces")
+		}
+
+		fresh := make([]*v1.BidTraceWithTimestamp, 0)
+		for _, trace := range traces {
+			if trace == nil || trace.Slot != slot {
+				continue
+			}
+			key := bidKey{blockHash: trace.BlockHash, timestampMs: trace.Timestamp.UnixMilli()}
+			if _, exists := seen[key]; exists {
+				continue
+			}
+			seen[key] = struct{}{}
+			fresh = append(fresh, trace)
+			if key.timestampMs > latestMs {
+				latestMs = key.timestampMs
+			}
+		}
+
+		// Relays do not guarantee an order, so sort to emit in order of receipt.
+		sort.SliceStable(fresh, func(i, j int) bool {
+			return fresh[i].Timestamp.Before(fresh[j].Timestamp)
+		})
+		for _, trace := range fresh {
+			select {
+			case bids <- trace:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if final {
+			log.Trace().Int("bids", len(seen)).Msg("Slot finished")
+			return
+		}
+
+		wait := s.pollInterval
+		if remaining := time.Until(deadline); remaining < wait {
+			wait = remaining
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// honoursMinTimestamp returns true if none of the traces were received
+// before the minimum timestamp, as would be the case if the relay applied it.
+func honoursMinTimestamp(traces []*v1.BidTraceWithTimestamp, minTimestampMs int64) bool {
+	for _, trace := range traces {
+		if trace != nil && trace.Timestamp.UnixMilli() < minTimestampMs {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,106 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+const (
+	deliveredBidTraceEndpoint = "proposer_payload_delivered"
+	receivedBidTracesEndpoint = "builder_blocks_received"
+)
+
+// DeliveredBidTrace provides a bid trace of a delivered payload for a given slot.
+// Will return nil if the relay did not deliver a bid for the slot.
+// Returns an error if the relay does not provide delivered bid traces.
+func (s *Service) DeliveredBidTrace(ctx context.Context, slot phase0.Slot) (*v1.BidTrace, error) {
+	provider, isProvider := s.relay.(client.DeliveredBidTraceProvider)
+	if !isProvider {
+		return nil, errors.New("relay does not provide delivered bid traces")
+	}
+
+	data, err := s.fetch(deliveredBidTraceEndpoint, slot)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to fetch delivered bid trace from cache")
+	}
+	if data != nil {
+		var trace *v1.BidTrace
+		decodeErr := json.Unmarshal(data, &trace)
+		if decodeErr == nil {
+			log.Trace().Uint64("slot", uint64(slot)).Msg("Delivered bid trace served from cache")
+			return trace, nil
+		}
+		log.Warn().Err(decodeErr).Msg("Failed to decode cached delivered bid trace; refetching")
+	}
+
+	trace, err := provider.DeliveredBidTrace(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = json.Marshal(trace)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode delivered bid trace")
+	}
+	if err := s.store(deliveredBidTraceEndpoint, slot, data); err != nil {
+		log.Warn().Err(err).Msg("Failed to store delivered bid trace in cache")
+	}
+
+	return trace, nil
+}
+
+// ReceivedBidTraces provides all bid traces received for a given slot.
+// Returns an error if the relay does not provide received bid traces.
+func (s *Service) ReceivedBidTraces(ctx context.Context, slot phase0.Slot) ([]*v1.BidTraceWithTimestamp, error) {
+	provider, isProvider := s.relay.(client.ReceivedBidTracesProvider)
+	if !isProvider {
+		return nil, errors.New("relay does not provide received bid traces")
+	}
+
+	data, err := s.fetch(receivedBidTracesEndpoint, slot)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to fetch received bid traces from cache")
+	}
+	if data != nil {
+		var traces []*v1.BidTraceWithTimestamp
+		decodeErr := json.Unmarshal(data, &traces)
+		if decodeErr == nil {
+			log.Trace().Uint64("slot", uint64(slot)).Msg("Received bid traces served from cache")
+			return traces, nil
+		}
+		log.Warn().Err(decodeErr).Msg("Failed to decode cached received bid traces; refetching")
+	}
+
+	traces, err := provider.ReceivedBidTraces(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = json.Marshal(traces)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode received bid traces")
+	}
+	if err := s.store(receivedBidTracesEndpoint, slot, data); err != nil {
+		log.Warn().Err(err).Msg("Failed to store received bid traces in cache")
+	}
+
+	return traces, nil
+}
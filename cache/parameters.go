@@ -0,0 +1,118 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"time"
+
+	client "github.com/attestantio/go-relay-client"
+	"github.com/attestantio/go-relay-client/chaintime"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	bolt "go.etcd.io/bbolt"
+)
+
+type parameters struct {
+	logLevel       zerolog.Level
+	relay          client.Service
+	db             *bolt.DB
+	chainTime      *chaintime.Service
+	finalityEpochs uint64
+	nonFinalTTL    time.Duration
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithRelay sets the relay whose responses are cached.
+func WithRelay(relay client.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.relay = relay
+	})
+}
+
+// WithDB sets the database in which responses are stored.
+// A single database can be shared by caches for multiple relays.
+func WithDB(db *bolt.DB) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.db = db
+	})
+}
+
+// WithChainTime sets the chain time service used to decide if a slot is final.
+func WithChainTime(service *chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = service
+	})
+}
+
+// WithFinalityEpochs sets the number of epochs after which a slot is
+// considered final.
+func WithFinalityEpochs(epochs uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.finalityEpochs = epochs
+	})
+}
+
+// WithNonFinalTTL sets the time for which responses for slots that are not
+// yet final are served from the cache.  0 disables caching for such slots.
+func WithNonFinalTTL(ttl time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.nonFinalTTL = ttl
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:       zerolog.GlobalLevel(),
+		finalityEpochs: 2,
+		nonFinalTTL:    time.Minute,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.relay == nil {
+		return nil, errors.New("no relay specified")
+	}
+	if parameters.db == nil {
+		return nil, errors.New("no database specified")
+	}
+	if parameters.chainTime == nil {
+		return nil, errors.New("no chain time specified")
+	}
+	if parameters.nonFinalTTL < 0 {
+		return nil, errors.New("non-final TTL cannot be negative")
+	}
+
+	return &parameters, nil
+}
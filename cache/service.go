@@ -0,0 +1,163 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache persists relay responses locally, so that historical data
+// is only fetched from a relay once.
+package cache
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	"github.com/attestantio/go-relay-client/chaintime"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Service is a caching decorator for a relay.
+//
+// The service implements both client.DeliveredBidTraceProvider and
+// client.ReceivedBidTracesProvider regardless of the relay it decorates, so
+// a type assertion on the service does not show what the relay provides.  If
+// the relay does not provide the data the call returns an error; check the
+// relay itself to find out in advance.
+type Service struct {
+	relay          client.Service
+	db             *bolt.DB
+	chainTime      *chaintime.Service
+	finalityEpochs uint64
+	nonFinalTTL    time.Duration
+}
+
+// log is a service-wide logger.
+var log zerolog.Logger
+
+// New creates a new cache service.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "cache").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		relay:          parameters.relay,
+		db:             parameters.db,
+		chainTime:      parameters.chainTime,
+		finalityEpochs: parameters.finalityEpochs,
+		nonFinalTTL:    parameters.nonFinalTTL,
+	}, nil
+}
+
+// Name returns the name of the relay.
+func (s *Service) Name() string {
+	return s.relay.Name()
+}
+
+// Address returns the address of the relay.
+func (s *Service) Address() string {
+	return s.relay.Address()
+}
+
+// Pubkey returns the public key of the relay (if any).
+func (s *Service) Pubkey() *phase0.BLSPubKey {
+	return s.relay.Pubkey()
+}
+
+// final returns true if the given slot is final.
+func (s *Service) final(slot phase0.Slot) bool {
+	return uint64(s.chainTime.SlotToEpoch(slot))+s.finalityEpochs <= uint64(s.chainTime.CurrentEpoch())
+}
+
+// slotKey returns the key for a slot.  Keys are big-endian so that they sort by slot.
+func slotKey(slot phase0.Slot) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(slot))
+
+	return key
+}
+
+// fetch returns the stored data for the endpoint and slot, or nil if there
+// is no data or it has expired.
+func (s *Service) fetch(endpoint string, slot phase0.Slot) ([]byte, error) {
+	var data []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		relayBucket := tx.Bucket([]byte(s.relay.Address()))
+		if relayBucket == nil {
+			return nil
+		}
+		endpointBucket := relayBucket.Bucket([]byte(endpoint))
+		if endpointBucket == nil {
+			return nil
+		}
+		value := endpointBucket.Get(slotKey(slot))
+		if len(value) < 8 {
+			return nil
+		}
+
+		if !s.final(slot) {
+			//nolint:gosec
+			stored := time.Unix(0, int64(binary.BigEndian.Uint64(value[:8])))
+			if time.Since(stored) >= s.nonFinalTTL {
+				return nil
+			}
+		}
+
+		// Values are only valid for the life of the transaction, so copy.
+		data = append([]byte{}, value[8:]...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read from cache")
+	}
+
+	return data, nil
+}
+
+// store stores the data for the endpoint and slot, prefixed with the time
+// at which it was stored.
+func (s *Service) store(endpoint string, slot phase0.Slot, data []byte) error {
+	value := make([]byte, 8+len(data))
+	//nolint:gosec
+	binary.BigEndian.PutUint64(value[:8], uint64(time.Now().UnixNano()))
+	copy(value[8:], data)
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		relayBucket, err := tx.CreateBucketIfNotExists([]byte(s.relay.Address()))
+		if err != nil {
+			return err
+		}
+		endpointBucket, err := relayBucket.CreateBucketIfNotExists([]byte(endpoint))
+		if err != nil {
+			return err
+		}
+
+		return endpointBucket.Put(slotKey(slot), value)
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to write to cache")
+	}
+
+	return nil
+}
@@ -0,0 +1,307 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/cache"
+	"github.com/attestantio/go-relay-client/chaintime"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+// basicRelay is a mock relay that provides no data.
+type basicRelay struct {
+	address string
+}
+
+func (r *basicRelay) Name() string              { return r.address }
+func (r *basicRelay) Address() string           { return r.address }
+func (r *basicRelay) Pubkey() *phase0.BLSPubKey { return nil }
+
+// relay is a mock relay that counts its calls.
+type relay struct {
+	basicRelay
+	err                    error
+	deliveredCalls         int
+	receivedCalls          int
+	deliveredBidTraceSlots map[phase0.Slot]bool
+}
+
+func (r *relay) DeliveredBidTrace(_ context.Context, slot phase0.Slot) (*v1.BidTrace, error) {
+	r.deliveredCalls++
+	if r.err != nil {
+		return nil, r.err
+	}
+	if !r.deliveredBidTraceSlots[slot] {
+		return nil, nil
+	}
+
+	return &v1.BidTrace{
+		Slot:  slot,
+		Value: big.NewInt(int64(slot)),
+	}, nil
+}
+
+func (r *relay) ReceivedBidTraces(_ context.Context, slot phase0.Slot) ([]*v1.BidTraceWithTimestamp, error) {
+	r.receivedCalls++
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return []*v1.BidTraceWithTimestamp{
+		{
+			Slot:      slot,
+			Value:     big.NewInt(1),
+			Timestamp: time.UnixMilli(1700000000123),
+		},
+	}, nil
+}
+
+func testDB(t *testing.T) *bolt.DB {
+	t.Helper()
+
+	db, err := bolt.Open(filepath.Join(t.TempDir(), "cache.db"), 0o600, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db
+}
+
+func testChainTime(t *testing.T) *chaintime.Service {
+	t.Helper()
+
+	// Genesis 100 epochs ago, so that early slots are final.
+	chainTime, err := chaintime.New(context.Background(),
+		chaintime.WithGenesisTime(time.Now().Add(-100*32*12*time.Second)),
+		chaintime.WithSlotDuration(12*time.Second),
+		chaintime.WithSlotsPerEpoch(32),
+	)
+	require.NoError(t, err)
+
+	return chainTime
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+	chainTime := testChainTime(t)
+
+	tests := []struct {
+		name   string
+		params []cache.Parameter
+		err    string
+	}{
+		{
+			name: "RelayMissing",
+			params: []cache.Parameter{
+				cache.WithDB(db),
+				cache.WithChainTime(chainTime),
+			},
+			err: "problem with parameters: no relay specified",
+		},
+		{
+			name: "DBMissing",
+			params: []cache.Parameter{
+				cache.WithRelay(&relay{}),
+				cache.WithChainTime(chainTime),
+			},
+			err: "problem with parameters: no database specified",
+		},
+		{
+			name: "ChainTimeMissing",
+			params: []cache.Parameter{
+				cache.WithRelay(&relay{}),
+				cache.WithDB(db),
+			},
+			err: "problem with parameters: no chain time specified",
+		},
+		{
+			name: "NonFinalTTLNegative",
+			params: []cache.Parameter{
+				cache.WithRelay(&relay{}),
+				cache.WithDB(db),
+				cache.WithChainTime(chainTime),
+				cache.WithNonFinalTTL(-time.Second),
+			},
+			err: "problem with parameters: non-final TTL cannot be negative",
+		},
+		{
+			name: "Good",
+			params: []cache.Parameter{
+				cache.WithRelay(&relay{}),
+				cache.WithDB(db),
+				cache.WithChainTime(chainTime),
+				cache.WithFinalityEpochs(4),
+				cache.WithNonFinalTTL(time.Second),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := cache.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeliveredBidTrace(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+	chainTime := testChainTime(t)
+
+	underlying := &relay{
+		basicRelay:             basicRelay{address: "https://relay.example.com/"},
+		deliveredBidTraceSlots: map[phase0.Slot]bool{1: true},
+	}
+	s, err := cache.New(ctx,
+		cache.WithRelay(underlying),
+		cache.WithDB(db),
+		cache.WithChainTime(chainTime),
+	)
+	require.NoError(t, err)
+	require.Equal(t, underlying.Address(), s.Address())
+
+	// Final slot with a delivery is fetched once.
+	for range 3 {
+		trace, err := s.DeliveredBidTrace(ctx, 1)
+		require.NoError(t, err)
+		require.NotNil(t, trace)
+		require.Equal(t, phase0.Slot(1), trace.Slot)
+		require.Equal(t, uint64(1), trace.Value.Uint64())
+	}
+	require.Equal(t, 1, underlying.deliveredCalls)
+
+	// Final slot without a delivery is also fetched once.
+	for range 3 {
+		trace, err := s.DeliveredBidTrace(ctx, 2)
+		require.NoError(t, err)
+		require.Nil(t, trace)
+	}
+	require.Equal(t, 2, underlying.deliveredCalls)
+
+	// Data persists for a new cache on the same database.
+	s2, err := cache.New(ctx,
+		cache.WithRelay(underlying),
+		cache.WithDB(db),
+		cache.WithChainTime(chainTime),
+	)
+	require.NoError(t, err)
+	_, err = s2.DeliveredBidTrace(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, underlying.deliveredCalls)
+
+	// Data is keyed by relay.
+	other := &relay{basicRelay: basicRelay{address: "https://other.example.com/"}}
+	s3, err := cache.New(ctx,
+		cache.WithRelay(other),
+		cache.WithDB(db),
+		cache.WithChainTime(chainTime),
+	)
+	require.NoError(t, err)
+	trace, err := s3.DeliveredBidTrace(ctx, 1)
+	require.NoError(t, err)
+	require.Nil(t, trace)
+	require.Equal(t, 1, other.deliveredCalls)
+}
+
+func TestNonFinal(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+	chainTime := testChainTime(t)
+	slot := chainTime.CurrentSlot()
+
+	underlying := &relay{basicRelay: basicRelay{address: "https://relay.example.com/"}}
+	s, err := cache.New(ctx,
+		cache.WithRelay(underlying),
+		cache.WithDB(db),
+		cache.WithChainTime(chainTime),
+		cache.WithNonFinalTTL(50*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	_, err = s.ReceivedBidTraces(ctx, slot)
+	require.NoError(t, err)
+	traces, err := s.ReceivedBidTraces(ctx, slot)
+	require.NoError(t, err)
+	require.Len(t, traces, 1)
+	require.Equal(t, time.UnixMilli(1700000000123).UnixMilli(), traces[0].Timestamp.UnixMilli())
+	require.Equal(t, 1, underlying.receivedCalls)
+
+	// Expired entries are refetched.
+	time.Sleep(100 * time.Millisecond)
+	_, err = s.ReceivedBidTraces(ctx, slot)
+	require.NoError(t, err)
+	require.Equal(t, 2, underlying.receivedCalls)
+
+	// A TTL of 0 disables caching of non-final slots.
+	s, err = cache.New(ctx,
+		cache.WithRelay(underlying),
+		cache.WithDB(db),
+		cache.WithChainTime(chainTime),
+		cache.WithNonFinalTTL(0),
+	)
+	require.NoError(t, err)
+	_, err = s.ReceivedBidTraces(ctx, slot)
+	require.NoError(t, err)
+	require.Equal(t, 3, underlying.receivedCalls)
+}
+
+func TestErrors(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+	chainTime := testChainTime(t)
+
+	s, err := cache.New(ctx,
+		cache.WithRelay(&basicRelay{address: "https://basic.example.com/"}),
+		cache.WithDB(db),
+		cache.WithChainTime(chainTime),
+	)
+	require.NoError(t, err)
+	_, err = s.DeliveredBidTrace(ctx, 1)
+	require.EqualError(t, err, "relay does not provide delivered bid traces")
+	_, err = s.ReceivedBidTraces(ctx, 1)
+	require.EqualError(t, err, "relay does not provide received bid traces")
+
+	// Errors are not cached.
+	underlying := &relay{
+		basicRelay: basicRelay{address: "https://relay.example.com/"},
+		err:        errors.New("relay down"),
+	}
+	s, err = cache.New(ctx,
+		cache.WithRelay(underlying),
+		cache.WithDB(db),
+		cache.WithChainTime(chainTime),
+	)
+	require.NoError(t, err)
+	_, err = s.DeliveredBidTrace(ctx, 1)
+	require.EqualError(t, err, "relay down")
+	underlying.err = nil
+	_, err = s.DeliveredBidTrace(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, underlying.deliveredCalls)
+}
@@ -0,0 +1,176 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chaintime converts between slots, epochs and wall-clock times.
+package chaintime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service provides chain time services.
+type Service struct {
+	genesisTime   time.Time
+	slotDuration  time.Duration
+	slotsPerEpoch uint64
+}
+
+// log is a service-wide logger.
+var log zerolog.Logger
+
+// New creates a new chain time service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "chaintime").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	genesisTime := parameters.genesisTime
+	if genesisTime.IsZero() {
+		genesisResponse, err := parameters.genesisProvider.Genesis(ctx, &api.GenesisOpts{})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain genesis")
+		}
+		genesisTime = genesisResponse.Data.GenesisTime
+	}
+	log.Trace().Time("genesis_time", genesisTime).Msg("Obtained genesis time")
+
+	slotDuration := parameters.slotDuration
+	slotsPerEpoch := parameters.slotsPerEpoch
+	if slotDuration == 0 || slotsPerEpoch == 0 {
+		specResponse, err := parameters.specProvider.Spec(ctx, &api.SpecOpts{})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain spec")
+		}
+		if slotDuration == 0 {
+			tmp, exists := specResponse.Data["SECONDS_PER_SLOT"]
+			if !exists {
+				return nil, errors.New("SECONDS_PER_SLOT not found in spec")
+			}
+			var isDuration bool
+			slotDuration, isDuration = tmp.(time.Duration)
+			if !isDuration {
+				return nil, fmt.Errorf("SECONDS_PER_SLOT of unexpected type %T", tmp)
+			}
+		}
+		if slotsPerEpoch == 0 {
+			tmp, exists := specResponse.Data["SLOTS_PER_EPOCH"]
+			if !exists {
+				return nil, errors.New("SLOTS_PER_EPOCH not found in spec")
+			}
+			var isUint64 bool
+			slotsPerEpoch, isUint64 = tmp.(uint64)
+			if !isUint64 {
+				return nil, fmt.Errorf("SLOTS_PER_EPOCH of unexpected type %T", tmp)
+			}
+		}
+	}
+	log.Trace().Dur("slot_duration", slotDuration).Uint64("slots_per_epoch", slotsPerEpoch).Msg("Obtained chain configuration")
+
+	if slotDuration <= 0 {
+		return nil, errors.New("slot duration must be positive")
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch must be positive")
+	}
+
+	return &Service{
+		genesisTime:   genesisTime,
+		slotDuration:  slotDuration,
+		slotsPerEpoch: slotsPerEpoch,
+	}, nil
+}
+
+// GenesisTime provides the time of the chain's genesis.
+func (s *Service) GenesisTime() time.Time {
+	return s.genesisTime
+}
+
+// SlotDuration provides the duration of a slot.
+func (s *Service) SlotDuration() time.Duration {
+	return s.slotDuration
+}
+
+// SlotsPerEpoch provides the number of slots in an epoch.
+func (s *Service) SlotsPerEpoch() uint64 {
+	return s.slotsPerEpoch
+}
+
+// StartOfSlot provides the time at which a given slot starts.
+func (s *Service) StartOfSlot(slot phase0.Slot) time.Time {
+	//nolint:gosec
+	return s.genesisTime.Add(time.Duration(slot) * s.slotDuration)
+}
+
+// StartOfEpoch provides the time at which a given epoch starts.
+func (s *Service) StartOfEpoch(epoch phase0.Epoch) time.Time {
+	return s.StartOfSlot(s.FirstSlotOfEpoch(epoch))
+}
+
+// CurrentSlot provides the current slot.
+func (s *Service) CurrentSlot() phase0.Slot {
+	return s.TimestampToSlot(time.Now())
+}
+
+// CurrentEpoch provides the current epoch.
+func (s *Service) CurrentEpoch() phase0.Epoch {
+	return s.SlotToEpoch(s.CurrentSlot())
+}
+
+// SlotToEpoch provides the epoch of a given slot.
+func (s *Service) SlotToEpoch(slot phase0.Slot) phase0.Epoch {
+	return phase0.Epoch(uint64(slot) / s.slotsPerEpoch)
+}
+
+// FirstSlotOfEpoch provides the first slot of the given epoch.
+func (s *Service) FirstSlotOfEpoch(epoch phase0.Epoch) phase0.Slot {
+	return phase0.Slot(uint64(epoch) * s.slotsPerEpoch)
+}
+
+// TimestampToSlot provides the slot of the given timestamp.
+// Timestamps before genesis are considered to be in slot 0.
+func (s *Service) TimestampToSlot(timestamp time.Time) phase0.Slot {
+	if timestamp.Before(s.genesisTime) {
+		return 0
+	}
+
+	//nolint:gosec
+	return phase0.Slot(uint64(timestamp.Sub(s.genesisTime) / s.slotDuration))
+}
+
+// TimestampToEpoch provides the epoch of the given timestamp.
+// Timestamps before genesis are considered to be in epoch 0.
+func (s *Service) TimestampToEpoch(timestamp time.Time) phase0.Epoch {
+	return s.SlotToEpoch(s.TimestampToSlot(timestamp))
+}
+
+// BidOffset provides the time of the bid relative to the start of its slot.
+// Bids received before the start of their slot have a negative offset.
+func (s *Service) BidOffset(bid *v1.BidTraceWithTimestamp) time.Duration {
+	return bid.Timestamp.Sub(s.StartOfSlot(bid.Slot))
+}
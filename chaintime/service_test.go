@@ -0,0 +1,145 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/chaintime"
+	"github.com/stretchr/testify/require"
+)
+
+type specProvider struct {
+	spec map[string]any
+}
+
+func (p *specProvider) Spec(_ context.Context, _ *api.SpecOpts) (*api.Response[map[string]any], error) {
+	return &api.Response[map[string]any]{Data: p.spec}, nil
+}
+
+type genesisProvider struct {
+	genesisTime time.Time
+}
+
+func (p *genesisProvider) Genesis(_ context.Context, _ *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error) {
+	return &api.Response[*apiv1.Genesis]{Data: &apiv1.Genesis{GenesisTime: p.genesisTime}}, nil
+}
+
+func TestNew(t *testing.T) {
+	genesisTime := time.Unix(1606824023, 0)
+
+	tests := []struct {
+		name   string
+		params []chaintime.Parameter
+		err    string
+	}{
+		{
+			name: "GenesisMissing",
+			params: []chaintime.Parameter{
+				chaintime.WithSlotDuration(12 * time.Second),
+				chaintime.WithSlotsPerEpoch(32),
+			},
+			err: "problem with parameters: no genesis time or genesis provider specified",
+		},
+		{
+			name: "SlotDurationMissing",
+			params: []chaintime.Parameter{
+				chaintime.WithGenesisTime(genesisTime),
+				chaintime.WithSlotsPerEpoch(32),
+			},
+			err: "problem with parameters: no slot duration and slots per epoch, or spec provider, specified",
+		},
+		{
+			name: "SpecSlotDurationMissing",
+			params: []chaintime.Parameter{
+				chaintime.WithGenesisTime(genesisTime),
+				chaintime.WithSpecProvider(&specProvider{spec: map[string]any{"SLOTS_PER_EPOCH": uint64(32)}}),
+			},
+			err: "SECONDS_PER_SLOT not found in spec",
+		},
+		{
+			name: "SpecSlotDurationWrongType",
+			params: []chaintime.Parameter{
+				chaintime.WithGenesisTime(genesisTime),
+				chaintime.WithSpecProvider(&specProvider{spec: map[string]any{"SECONDS_PER_SLOT": "12", "SLOTS_PER_EPOCH": uint64(32)}}),
+			},
+			err: "SECONDS_PER_SLOT of unexpected type string",
+		},
+		{
+			name: "Direct",
+			params: []chaintime.Parameter{
+				chaintime.WithGenesisTime(genesisTime),
+				chaintime.WithSlotDuration(12 * time.Second),
+				chaintime.WithSlotsPerEpoch(32),
+			},
+		},
+		{
+			name: "Providers",
+			params: []chaintime.Parameter{
+				chaintime.WithGenesisProvider(&genesisProvider{genesisTime: genesisTime}),
+				chaintime.WithSpecProvider(&specProvider{spec: map[string]any{"SECONDS_PER_SLOT": 12 * time.Second, "SLOTS_PER_EPOCH": uint64(32)}}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := chaintime.New(context.Background(), test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, genesisTime, s.GenesisTime())
+				require.Equal(t, 12*time.Second, s.SlotDuration())
+				require.Equal(t, uint64(32), s.SlotsPerEpoch())
+			}
+		})
+	}
+}
+
+func TestConversions(t *testing.T) {
+	genesisTime := time.Unix(1606824023, 0)
+	s, err := chaintime.New(context.Background(),
+		chaintime.WithGenesisTime(genesisTime),
+		chaintime.WithSlotDuration(12*time.Second),
+		chaintime.WithSlotsPerEpoch(32),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, genesisTime, s.StartOfSlot(0))
+	require.Equal(t, time.Unix(1606824023+12*100, 0), s.StartOfSlot(100))
+	require.Equal(t, time.Unix(1606824023+12*64, 0), s.StartOfEpoch(2))
+	require.Equal(t, phase0.Epoch(3), s.SlotToEpoch(100))
+	require.Equal(t, phase0.Slot(96), s.FirstSlotOfEpoch(3))
+	require.Equal(t, phase0.Slot(0), s.TimestampToSlot(genesisTime.Add(-time.Hour)))
+	require.Equal(t, phase0.Slot(100), s.TimestampToSlot(time.Unix(1606824023+12*100+11, 0)))
+	require.Equal(t, phase0.Epoch(3), s.TimestampToEpoch(time.Unix(1606824023+12*100, 0)))
+	require.Equal(t, s.TimestampToSlot(time.Now()), s.CurrentSlot())
+	require.Equal(t, s.SlotToEpoch(s.CurrentSlot()), s.CurrentEpoch())
+
+	require.Equal(t, -1500*time.Millisecond, s.BidOffset(&v1.BidTraceWithTimestamp{
+		Slot:      100,
+		Timestamp: time.Unix(1606824023+12*100, 0).Add(-1500 * time.Millisecond),
+	}))
+	require.Equal(t, 250*time.Millisecond, s.BidOffset(&v1.BidTraceWithTimestamp{
+		Slot:      100,
+		Timestamp: time.Unix(1606824023+12*100, 0).Add(250 * time.Millisecond),
+	}))
+}
@@ -0,0 +1,163 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// backfill fetches delivered, and optionally received, bid traces for a
+// range of slots from a set of relays.
+//
+// Output is written as NDJSON to files in the output directory, one per
+// relay and type of data.  Progress is recorded in a checkpoint file in the
+// same directory, and rerunning the same backfill resumes from it, appending
+// to the existing output.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/backfill"
+	"github.com/attestantio/go-relay-client/export"
+	"github.com/attestantio/go-relay-client/http"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type options struct {
+	relays      string
+	first       uint64
+	last        uint64
+	received    bool
+	output      string
+	concurrency int
+	rateLimit   float64
+	timeout     time.Duration
+}
+
+func main() {
+	opts := &options{}
+	flag.StringVar(&opts.relays, "relays", "", "comma-separated list of relay addresses")
+	flag.Uint64Var(&opts.first, "first", 0, "first slot to backfill")
+	flag.Uint64Var(&opts.last, "last", 0, "last slot to backfill")
+	flag.BoolVar(&opts.received, "received", false, "also backfill received bid traces")
+	flag.StringVar(&opts.output, "output", ".", "directory for output and checkpoint files")
+	flag.IntVar(&opts.concurrency, "concurrency", 4, "number of slots to backfill concurrently")
+	flag.Float64Var(&opts.rateLimit, "rate-limit", 10, "maximum requests per second to each relay (0 for no limit)")
+	flag.DurationVar(&opts.timeout, "timeout", 30*time.Second, "timeout for relay requests")
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if err := run(ctx, opts); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, opts *options) error {
+	if opts.relays == "" {
+		return errors.New("no relays specified")
+	}
+	if err := os.MkdirAll(opts.output, 0o750); err != nil {
+		return errors.Wrap(err, "failed to create output directory")
+	}
+
+	relays := make([]client.DeliveredBidTraceProvider, 0)
+	delivered := make(map[string]backfill.RecordWriter[*v1.BidTrace])
+	received := make(map[string]backfill.RecordWriter[*v1.BidTraceWithTimestamp])
+	files := make([]io.Closer, 0)
+	defer func() {
+		for _, file := range files {
+			_ = file.Close()
+		}
+	}()
+
+	for _, address := range strings.Split(opts.relays, ",") {
+		relay, err := http.New(ctx,
+			http.WithLogLevel(zerolog.Disabled),
+			http.WithAddress(strings.TrimSpace(address)),
+			http.WithTimeout(opts.timeout),
+		)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to create client for relay %s", address))
+		}
+		relays = append(relays, relay.(client.DeliveredBidTraceProvider))
+
+		prefix := filePrefix(relay.Address())
+		f, err := openOutput(opts.output, prefix+"-delivered.ndjson")
+		if err != nil {
+			return err
+		}
+		files = append(files, f)
+		delivered[relay.Name()] = export.NewNDJSONWriter(f, export.BidTraceSchema, export.TimestampRFC3339)
+
+		if opts.received {
+			f, err := openOutput(opts.output, prefix+"-received.ndjson")
+			if err != nil {
+				return err
+			}
+			files = append(files, f)
+			received[relay.Name()] = export.NewNDJSONWriter(f, export.BidTraceWithTimestampSchema, export.TimestampRFC3339)
+		}
+	}
+
+	runner, err := backfill.New(ctx,
+		backfill.WithLogLevel(zerolog.Disabled),
+		backfill.WithRelays(relays),
+		backfill.WithReceivedBidTraces(opts.received),
+		backfill.WithSink(backfill.NewExportSink(delivered, received)),
+		backfill.WithConcurrency(opts.concurrency),
+		backfill.WithRateLimit(opts.rateLimit),
+		backfill.WithCheckpointFile(filepath.Join(opts.output, "checkpoint.json")),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create backfill")
+	}
+
+	if err := runner.Run(ctx, phase0.Slot(opts.first), phase0.Slot(opts.last)); err != nil {
+		return errors.Wrap(err, "backfill failed; rerun to resume")
+	}
+
+	return nil
+}
+
+// filePrefix provides a prefix for a relay's output files from its address.
+func filePrefix(address string) string {
+	base, err := url.Parse(address)
+	if err != nil || base.Host == "" {
+		return strings.NewReplacer("/", "_", ":", "_").Replace(address)
+	}
+
+	return strings.ReplaceAll(base.Host, ":", "_")
+}
+
+// openOutput opens an output file for appending.
+func openOutput(dir string, name string) (*os.File, error) {
+	f, err := os.OpenFile(filepath.Join(dir, name), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o640)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to open %s", name))
+	}
+
+	return f, nil
+}
@@ -0,0 +1,160 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// checkregistrations checks that relays hold the expected registrations for a set of validators.
+//
+// Validators are supplied as a CSV file with lines of the form
+//
+//	pubkey,fee_recipient,gas_limit
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	"github.com/attestantio/go-relay-client/compliance"
+	"github.com/attestantio/go-relay-client/http"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+func main() {
+	relays := flag.String("relays", "", "comma-separated list of relay addresses")
+	validators := flag.String("validators", "", "CSV file of pubkey,fee_recipient,gas_limit")
+	maxAge := flag.Duration("max-age", 0, "age after which a registration is considered stale (0 to disable)")
+	timeout := flag.Duration("timeout", 30*time.Second, "timeout for relay requests")
+	flag.Parse()
+
+	compliant, err := run(context.Background(), *relays, *validators, *maxAge, *timeout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(2)
+	}
+	if !compliant {
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context,
+	relayAddresses string,
+	validatorsFile string,
+	maxAge time.Duration,
+	timeout time.Duration,
+) (
+	bool,
+	error,
+) {
+	if relayAddresses == "" {
+		return false, errors.New("no relays specified")
+	}
+	if validatorsFile == "" {
+		return false, errors.New("no validators file specified")
+	}
+
+	f, err := os.Open(validatorsFile)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to open validators file")
+	}
+	defer f.Close()
+	expectations, err := parseExpectations(f)
+	if err != nil {
+		return false, err
+	}
+
+	relays := make([]client.ValidatorRegistrationProvider, 0)
+	for _, address := range strings.Split(relayAddresses, ",") {
+		relay, err := http.New(ctx,
+			http.WithLogLevel(zerolog.Disabled),
+			http.WithAddress(strings.TrimSpace(address)),
+			http.WithTimeout(timeout),
+		)
+		if err != nil {
+			return false, errors.Wrap(err, fmt.Sprintf("failed to create client for relay %s", address))
+		}
+		relays = append(relays, relay.(client.ValidatorRegistrationProvider))
+	}
+
+	checker, err := compliance.New(ctx,
+		compliance.WithLogLevel(zerolog.Disabled),
+		compliance.WithRelays(relays),
+		compliance.WithMaxRegistrationAge(maxAge),
+	)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to create compliance checker")
+	}
+
+	report, err := checker.CheckRegistrations(ctx, expectations)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to check registrations")
+	}
+	if err := report.WriteTable(os.Stdout); err != nil {
+		return false, errors.Wrap(err, "failed to write report")
+	}
+
+	return report.Compliant(), nil
+}
+
+// parseExpectations parses expectations from CSV lines of pubkey,fee_recipient,gas_limit.
+func parseExpectations(r io.Reader) ([]*compliance.Expectation, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read validators")
+	}
+
+	expectations := make([]*compliance.Expectation, 0, len(records))
+	for i, record := range records {
+		expectation := &compliance.Expectation{}
+
+		pubkey, err := hex.DecodeString(strings.TrimPrefix(record[0], "0x"))
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("invalid pubkey on line %d", i+1))
+		}
+		if len(pubkey) != phase0.PublicKeyLength {
+			return nil, fmt.Errorf("incorrect length for pubkey on line %d", i+1)
+		}
+		copy(expectation.Pubkey[:], pubkey)
+
+		feeRecipient, err := hex.DecodeString(strings.TrimPrefix(record[1], "0x"))
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("invalid fee recipient on line %d", i+1))
+		}
+		if len(feeRecipient) != bellatrix.FeeRecipientLength {
+			return nil, fmt.Errorf("incorrect length for fee recipient on line %d", i+1)
+		}
+		copy(expectation.FeeRecipient[:], feeRecipient)
+
+		expectation.GasLimit, err = strconv.ParseUint(record[2], 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("invalid gas limit on line %d", i+1))
+		}
+
+		expectations = append(expectations, expectation)
+	}
+
+	return expectations, nil
+}
@@ -0,0 +1,96 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// relayproxy serves the relay data API, answering each query from a set of relays.
+//
+// Records in responses carry an additional "relays" field listing the
+// relays that reported them.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	client "github.com/attestantio/go-relay-client"
+	"github.com/attestantio/go-relay-client/http"
+	"github.com/attestantio/go-relay-client/proxy"
+	"github.com/attestantio/go-relay-client/server"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+func main() {
+	relays := flag.String("relays", "", "comma-separated list of relay addresses")
+	listen := flag.String("listen", "127.0.0.1:18550", "address on which to listen")
+	timeout := flag.Duration("timeout", 10*time.Second, "timeout for relay requests")
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if err := run(ctx, *relays, *listen, *timeout); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context,
+	relayAddresses string,
+	listenAddress string,
+	timeout time.Duration,
+) error {
+	if relayAddresses == "" {
+		return errors.New("no relays specified")
+	}
+
+	relays := make([]client.Service, 0)
+	for _, address := range strings.Split(relayAddresses, ",") {
+		relay, err := http.New(ctx,
+			http.WithLogLevel(zerolog.Disabled),
+			http.WithAddress(strings.TrimSpace(address)),
+			http.WithTimeout(timeout),
+		)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to create client for relay %s", address))
+		}
+		relays = append(relays, relay)
+	}
+
+	store, err := proxy.New(ctx,
+		proxy.WithRelays(relays),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create proxy")
+	}
+
+	s, err := server.New(ctx,
+		server.WithListenAddress(listenAddress),
+		server.WithStore(store),
+		// Allow time for the relays to respond.
+		server.WithTimeout(timeout+time.Second),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to start server")
+	}
+	fmt.Fprintf(os.Stderr, "Listening on %s\n", s.Address())
+
+	<-ctx.Done()
+
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compliance
+
+import (
+	builderv1 "github.com/attestantio/go-builder-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// gasLimitAdjustmentFactor is the protocol's bound divisor for gas limit
+// changes; a block's gas limit may move from its parent's by less than
+// parent / gasLimitAdjustmentFactor, so at most by one less than that.
+const gasLimitAdjustmentFactor = 1024
+
+// DeliveryResult is the result of checking a delivered payload against the
+// registration that the relay served for its slot and proposer.
+type DeliveryResult struct {
+	Trace *v1.BidTrace
+	// Registration is the registration served for the slot, if any.
+	Registration *builderv1.ValidatorRegistration
+	// Issues are the problems found with the delivered payload.
+	Issues []Issue
+}
+
+// Consistent returns true if the delivered payload honours the registration.
+func (r *DeliveryResult) Consistent() bool {
+	return len(r.Issues) == 0
+}
+
+type slotProposer struct {
+	slot   phase0.Slot
+	pubkey phase0.BLSPubKey
+}
+
+// CheckDeliveries checks each delivered payload against the registration the
+// relay served in its queued proposers for the same slot and proposer.
+// Deliveries without a matching queued proposer have IssueMissing.
+//
+// The gas limits of parent blocks are taken from parentGasLimits, keyed by
+// block hash, or else from the trace of the parent if it is in traces.  If
+// neither is available the gas limit of the delivery is not checked.
+func CheckDeliveries(traces []*v1.BidTrace,
+	proposers []*v1.QueuedProposer,
+	parentGasLimits map[phase0.Hash32]uint64,
+) []*DeliveryResult {
+	registrations := make(map[slotProposer]*builderv1.ValidatorRegistration, len(proposers))
+	for _, proposer := range proposers {
+		if proposer == nil || proposer.Entry == nil || proposer.Entry.Message == nil {
+			continue
+		}
+		registrations[slotProposer{
+			slot:   proposer.Slot,
+			pubkey: proposer.Entry.Message.Pubkey,
+		}] = proposer.Entry.Message
+	}
+
+	gasLimits := make(map[phase0.Hash32]uint64, len(traces)+len(parentGasLimits))
+	for _, trace := range traces {
+		if trace != nil {
+			gasLimits[trace.BlockHash] = trace.GasLimit
+		}
+	}
+	for hash, gasLimit := range parentGasLimits {
+		gasLimits[hash] = gasLimit
+	}
+
+	results := make([]*DeliveryResult, 0, len(traces))
+	for _, trace := range traces {
+		if trace == nil {
+			continue
+		}
+		registration := registrations[slotProposer{
+			slot:   trace.Slot,
+			pubkey: trace.ProposerPubkey,
+		}]
+		results = append(results, &DeliveryResult{
+			Trace:        trace,
+			Registration: registration,
+			Issues:       checkDelivery(trace, registration, gasLimits[trace.ParentHash]),
+		})
+	}
+
+	return results
+}
+
+// CheckDelivery checks a delivered payload against a registration.
+//
+// The fee recipient must match exactly.  The gas limit of a block can only
+// move from its parent's gas limit by a limited step, so while the chain
+// converges on the registered gas limit a delivered gas limit may differ from
+// it.  The builder must move the gas limit from the parent's as far toward
+// the registered value as the protocol allows, which is the full step or the
+// remaining distance, whichever is smaller; anything else is a mismatch.  A
+// parent gas limit of 0 means that it is unknown, in which case the gas limit
+// is not checked.
+//
+// An error is returned if there is no trace, for example because the relay
+// did not deliver a payload for the slot.
+func CheckDelivery(trace *v1.BidTrace, registration *builderv1.ValidatorRegistration, parentGasLimit uint64) ([]Issue, error) {
+	if trace == nil {
+		return nil, errors.New("no trace supplied")
+	}
+
+	return checkDelivery(trace, registration, parentGasLimit), nil
+}
+
+// checkDelivery checks a delivered payload against a registration, as per
+// CheckDelivery.  The trace must not be nil.
+func checkDelivery(trace *v1.BidTrace, registration *builderv1.ValidatorRegistration, parentGasLimit uint64) []Issue {
+	if registration == nil {
+		return []Issue{IssueMissing}
+	}
+
+	issues := make([]Issue, 0)
+	if trace.ProposerFeeRecipient != registration.FeeRecipient {
+		issues = append(issues, IssueFeeRecipientMismatch)
+	}
+
+	if parentGasLimit != 0 && trace.GasLimit != expectedGasLimit(parentGasLimit, registration.GasLimit) {
+		issues = append(issues, IssueGasLimitMismatch)
+	}
+
+	return issues
+}
+
+// expectedGasLimit returns the gas limit of a block that moves from its
+// parent's gas limit as far toward the target as the protocol allows.
+func expectedGasLimit(parent uint64, target uint64) uint64 {
+	step := parent / gasLimitAdjustmentFactor
+	if step > 0 {
+		step--
+	}
+
+	switch {
+	case target > parent:
+		return parent + min(step, target-parent)
+	case target < parent:
+		return parent - min(step, parent-target)
+	default:
+		return parent
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compliance_test
+
+import (
+	"testing"
+
+	builderv1 "github.com/attestantio/go-builder-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/compliance"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDelivery(t *testing.T) {
+	feeRecipient := bellatrix.ExecutionAddress{0x01}
+	registration := &builderv1.ValidatorRegistration{
+		FeeRecipient: feeRecipient,
+		GasLimit:     36000000,
+	}
+
+	tests := []struct {
+		name           string
+		trace          *v1.BidTrace
+		registration   *builderv1.ValidatorRegistration
+		parentGasLimit uint64
+		issues         []compliance.Issue
+	}{
+		{
+			name:           "NoRegistration",
+			trace:          &v1.BidTrace{ProposerFeeRecipient: feeRecipient, GasLimit: 36000000},
+			parentGasLimit: 36000000,
+			issues:         []compliance.Issue{compliance.IssueMissing},
+		},
+		{
+			name:           "Good",
+			trace:          &v1.BidTrace{ProposerFeeRecipient: feeRecipient, GasLimit: 36000000},
+			registration:   registration,
+			parentGasLimit: 36000000,
+			issues:         []compliance.Issue{},
+		},
+		{
+			// 30000000 + (30000000/1024 - 1).
+			name:           "GasLimitRising",
+			trace:          &v1.BidTrace{ProposerFeeRecipient: feeRecipient, GasLimit: 30029295},
+			registration:   registration,
+			parentGasLimit: 30000000,
+			issues:         []compliance.Issue{},
+		},
+		{
+			name:           "GasLimitRisingTooSlowly",
+			trace:          &v1.BidTrace{ProposerFeeRecipient: feeRecipient, GasLimit: 30010000},
+			registration:   registration,
+			parentGasLimit: 30000000,
+			issues:         []compliance.Issue{compliance.IssueGasLimitMismatch},
+		},
+		{
+			name:           "GasLimitRisingNotMoved",
+			trace:          &v1.BidTrace{ProposerFeeRecipient: feeRecipient, GasLimit: 30000000},
+			registration:   registration,
+			parentGasLimit: 30000000,
+			issues:         []compliance.Issue{compliance.IssueGasLimitMismatch},
+		},
+		{
+			name:           "GasLimitReachingTarget",
+			trace:          &v1.BidTrace{ProposerFeeRecipient: feeRecipient, GasLimit: 36000000},
+			registration:   registration,
+			parentGasLimit: 35990000,
+			issues:         []compliance.Issue{},
+		},
+		{
+			name:           "GasLimitOvershot",
+			trace:          &v1.BidTrace{ProposerFeeRecipient: feeRecipient, GasLimit: 36020000},
+			registration:   registration,
+			parentGasLimit: 35990000,
+			issues:         []compliance.Issue{compliance.IssueGasLimitMismatch},
+		},
+		{
+			// 40000000 - (40000000/1024 - 1).
+			name:           "GasLimitFalling",
+			trace:          &v1.BidTrace{ProposerFeeRecipient: feeRecipient, GasLimit: 39960939},
+			registration:   registration,
+			parentGasLimit: 40000000,
+			issues:         []compliance.Issue{},
+		},
+		{
+			name:           "GasLimitMovedAway",
+			trace:          &v1.BidTrace{ProposerFeeRecipient: feeRecipient, GasLimit: 35980000},
+			registration:   registration,
+			parentGasLimit: 36000000,
+			issues:         []compliance.Issue{compliance.IssueGasLimitMismatch},
+		},
+		{
+			name:         "ParentGasLimitUnknown",
+			trace:        &v1.BidTrace{ProposerFeeRecipient: feeRecipient, GasLimit: 30000000},
+			registration: registration,
+			issues:       []compliance.Issue{},
+		},
+		{
+			name:           "FeeRecipientMismatch",
+			trace:          &v1.BidTrace{ProposerFeeRecipient: bellatrix.ExecutionAddress{0x02}, GasLimit: 36000000},
+			registration:   registration,
+			parentGasLimit: 36000000,
+			issues:         []compliance.Issue{compliance.IssueFeeRecipientMismatch},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			issues, err := compliance.CheckDelivery(test.trace, test.registration, test.parentGasLimit)
+			require.NoError(t, err)
+			require.Equal(t, test.issues, issues)
+		})
+	}
+}
+
+func TestCheckDeliveryNoTrace(t *testing.T) {
+	_, err := compliance.CheckDelivery(nil, &builderv1.ValidatorRegistration{}, 36000000)
+	require.EqualError(t, err, "no trace supplied")
+}
+
+func TestCheckDeliveries(t *testing.T) {
+	feeRecipient := bellatrix.ExecutionAddress{0x01}
+	proposer := phase0.BLSPubKey{0x01}
+
+	proposers := []*v1.QueuedProposer{
+		{
+			Slot: 1,
+			Entry: &builderv1.SignedValidatorRegistration{
+				Message: &builderv1.ValidatorRegistration{Pubkey: proposer, FeeRecipient: feeRecipient, GasLimit: 36000000},
+			},
+		},
+		{
+			Slot: 2,
+			Entry: &builderv1.SignedValidatorRegistration{
+				Message: &builderv1.ValidatorRegistration{Pubkey: proposer, FeeRecipient: feeRecipient, GasLimit: 36000000},
+			},
+		},
+		nil,
+	}
+	traces := []*v1.BidTrace{
+		{Slot: 1, ParentHash: phase0.Hash32{0x00}, BlockHash: phase0.Hash32{0x01}, ProposerPubkey: proposer, ProposerFeeRecipient: feeRecipient, GasLimit: 36000000},
+		{Slot: 2, ParentHash: phase0.Hash32{0x01}, BlockHash: phase0.Hash32{0x02}, ProposerPubkey: proposer, ProposerFeeRecipient: bellatrix.ExecutionAddress{0x02}, GasLimit: 35980000},
+		{Slot: 3, ParentHash: phase0.Hash32{0x02}, BlockHash: phase0.Hash32{0x03}, ProposerPubkey: proposer, ProposerFeeRecipient: feeRecipient, GasLimit: 36000000},
+		nil,
+	}
+
+	results := compliance.CheckDeliveries(traces, proposers, map[phase0.Hash32]uint64{{0x00}: 35000000})
+	require.Len(t, results, 3)
+	// The parent gas limit is supplied, and the builder did not move toward the target.
+	require.Equal(t, []compliance.Issue{compliance.IssueGasLimitMismatch}, results[0].Issues)
+	require.NotNil(t, results[0].Registration)
+	// The parent gas limit is from the previous trace, and the builder moved away from the target.
+	require.Equal(t, []compliance.Issue{compliance.IssueFeeRecipientMismatch, compliance.IssueGasLimitMismatch}, results[1].Issues)
+	require.Equal(t, []compliance.Issue{compliance.IssueMissing}, results[2].Issues)
+	require.Nil(t, results[2].Registration)
+}
@@ -0,0 +1,84 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compliance
+
+import (
+	"time"
+
+	client "github.com/attestantio/go-relay-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel           zerolog.Level
+	relays             []client.ValidatorRegistrationProvider
+	maxRegistrationAge time.Duration
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithRelays sets the relays to check.
+// Relays that also provide queued proposers will have their queued
+// proposers used in preference to individual registration lookups.
+func WithRelays(relays []client.ValidatorRegistrationProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.relays = relays
+	})
+}
+
+// WithMaxRegistrationAge sets the age after which a registration is considered stale.
+// If not set, registrations are never considered stale.
+func WithMaxRegistrationAge(maxAge time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxRegistrationAge = maxAge
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if len(parameters.relays) == 0 {
+		return nil, errors.New("no relays specified")
+	}
+	if parameters.maxRegistrationAge < 0 {
+		return nil, errors.New("max registration age cannot be negative")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,247 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compliance
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	builderv1 "github.com/attestantio/go-builder-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	"github.com/pkg/errors"
+)
+
+// Expectation is the registration expected for a validator.
+type Expectation struct {
+	Pubkey       phase0.BLSPubKey
+	FeeRecipient bellatrix.ExecutionAddress
+	GasLimit     uint64
+}
+
+// Issue is a problem with a registration held by a relay.
+type Issue int
+
+const (
+	// IssueMissing means the relay holds no registration for the validator.
+	IssueMissing Issue = iota
+	// IssueStale means the registration's timestamp is too old.
+	IssueStale
+	// IssueFeeRecipientMismatch means the registration has an unexpected fee recipient.
+	IssueFeeRecipientMismatch
+	// IssueGasLimitMismatch means the registration has an unexpected gas limit.
+	IssueGasLimitMismatch
+)
+
+var issueStrings = [...]string{
+	"missing",
+	"stale",
+	"fee recipient mismatch",
+	"gas limit mismatch",
+}
+
+// String returns a string representation of the issue.
+func (i Issue) String() string {
+	if int(i) >= len(issueStrings) {
+		return "unknown"
+	}
+
+	return issueStrings[i]
+}
+
+// RegistrationResult is the result of checking a validator's registration at a relay.
+type RegistrationResult struct {
+	Relay  string
+	Pubkey phase0.BLSPubKey
+	// Registration is the registration held by the relay, if any.
+	Registration *builderv1.ValidatorRegistration
+	// Issues are the problems found with the registration.
+	Issues []Issue
+	// Err is set if the relay could not be queried for the validator.
+	Err error
+}
+
+// Compliant returns true if the relay holds the expected registration.
+func (r *RegistrationResult) Compliant() bool {
+	return r.Err == nil && len(r.Issues) == 0
+}
+
+// RegistrationReport is the result of checking validator registrations across relays.
+type RegistrationReport struct {
+	// Results are ordered by validator, then by relay, in the order supplied.
+	Results []*RegistrationResult
+}
+
+// Compliant returns true if all relays hold the expected registrations.
+func (r *RegistrationReport) Compliant() bool {
+	for _, result := range r.Results {
+		if !result.Compliant() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// WriteTable writes the report as a human-readable table.
+func (r *RegistrationReport) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "VALIDATOR\tRELAY\tSTATUS\tFEE RECIPIENT\tGAS LIMIT\tTIMESTAMP"); err != nil {
+		return err
+	}
+	for _, result := range r.Results {
+		status := "ok"
+		switch {
+		case result.Err != nil:
+			status = fmt.Sprintf("error: %v", result.Err)
+		case len(result.Issues) > 0:
+			issues := make([]string, len(result.Issues))
+			for i := range result.Issues {
+				issues[i] = result.Issues[i].String()
+			}
+			status = strings.Join(issues, ", ")
+		}
+		feeRecipient := "-"
+		gasLimit := "-"
+		timestamp := "-"
+		if result.Registration != nil {
+			feeRecipient = result.Registration.FeeRecipient.String()
+			gasLimit = fmt.Sprintf("%d", result.Registration.GasLimit)
+			timestamp = result.Registration.Timestamp.UTC().Format(time.RFC3339)
+		}
+		if _, err := fmt.Fprintf(tw, "%#x\t%s\t%s\t%s\t%s\t%s\n",
+			result.Pubkey,
+			result.Relay,
+			status,
+			feeRecipient,
+			gasLimit,
+			timestamp,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tw.Flush()
+}
+
+// CheckRegistrations checks the registrations held by each relay against
+// those expected for the validators.
+func (s *Service) CheckRegistrations(ctx context.Context,
+	expectations []*Expectation,
+) (
+	*RegistrationReport,
+	error,
+) {
+	if len(expectations) == 0 {
+		return nil, errors.New("no expectations supplied")
+	}
+
+	relayResults := make([][]*RegistrationResult, len(s.relays))
+	var wg sync.WaitGroup
+	for i := range s.relays {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			relayResults[i] = s.checkRelay(ctx, s.relays[i], expectations)
+		}(i)
+	}
+	wg.Wait()
+
+	report := &RegistrationReport{
+		Results: make([]*RegistrationResult, 0, len(expectations)*len(s.relays)),
+	}
+	for i := range expectations {
+		for j := range s.relays {
+			report.Results = append(report.Results, relayResults[j][i])
+		}
+	}
+
+	return report, nil
+}
+
+// checkRelay checks the registrations held by a single relay.
+func (s *Service) checkRelay(ctx context.Context,
+	relay client.ValidatorRegistrationProvider,
+	expectations []*Expectation,
+) []*RegistrationResult {
+	// Queued proposers provide registrations for upcoming proposers in a
+	// single call, so use them where available.
+	queued := make(map[phase0.BLSPubKey]*builderv1.ValidatorRegistration)
+	if provider, isProvider := relay.(client.QueuedProposersProvider); isProvider {
+		proposers, err := provider.QueuedProposers(ctx)
+		if err != nil {
+			log.Debug().Str("relay", relay.Name()).Err(err).Msg("Failed to obtain queued proposers; falling back to lookups")
+		}
+		for _, proposer := range proposers {
+			if proposer == nil || proposer.Entry == nil || proposer.Entry.Message == nil {
+				continue
+			}
+			queued[proposer.Entry.Message.Pubkey] = proposer.Entry.Message
+		}
+	}
+
+	results := make([]*RegistrationResult, len(expectations))
+	for i, expectation := range expectations {
+		result := &RegistrationResult{
+			Relay:  relay.Name(),
+			Pubkey: expectation.Pubkey,
+			Issues: make([]Issue, 0),
+		}
+		results[i] = result
+
+		registration, exists := queued[expectation.Pubkey]
+		if !exists {
+			signedRegistration, err := relay.ValidatorRegistration(ctx, expectation.Pubkey)
+			if err != nil {
+				result.Err = err
+				continue
+			}
+			if signedRegistration != nil {
+				registration = signedRegistration.Message
+			}
+		}
+		result.Registration = registration
+		result.Issues = s.registrationIssues(expectation, registration)
+	}
+
+	return results
+}
+
+// registrationIssues returns the issues with a registration given the expectation.
+func (s *Service) registrationIssues(expectation *Expectation,
+	registration *builderv1.ValidatorRegistration,
+) []Issue {
+	if registration == nil {
+		return []Issue{IssueMissing}
+	}
+
+	issues := make([]Issue, 0)
+	if s.maxRegistrationAge > 0 && time.Since(registration.Timestamp) > s.maxRegistrationAge {
+		issues = append(issues, IssueStale)
+	}
+	if registration.FeeRecipient != expectation.FeeRecipient {
+		issues = append(issues, IssueFeeRecipientMismatch)
+	}
+	if registration.GasLimit != expectation.GasLimit {
+		issues = append(issues, IssueGasLimitMismatch)
+	}
+
+	return issues
+}
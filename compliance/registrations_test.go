@@ -0,0 +1,164 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compliance_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	builderv1 "github.com/attestantio/go-builder-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/compliance"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// lookupRelay is a mock relay that provides registration lookups.
+type lookupRelay struct {
+	name          string
+	registrations map[phase0.BLSPubKey]*builderv1.ValidatorRegistration
+	lookups       int
+	err           error
+}
+
+func (r *lookupRelay) Name() string              { return r.name }
+func (r *lookupRelay) Address() string           { return r.name }
+func (r *lookupRelay) Pubkey() *phase0.BLSPubKey { return nil }
+
+func (r *lookupRelay) ValidatorRegistration(_ context.Context,
+	pubkey phase0.BLSPubKey,
+) (
+	*builderv1.SignedValidatorRegistration,
+	error,
+) {
+	r.lookups++
+	if r.err != nil {
+		return nil, r.err
+	}
+	registration, exists := r.registrations[pubkey]
+	if !exists {
+		return nil, nil
+	}
+
+	return &builderv1.SignedValidatorRegistration{Message: registration}, nil
+}
+
+// queuedRelay is a mock relay that also provides queued proposers.
+type queuedRelay struct {
+	lookupRelay
+	queued []*v1.QueuedProposer
+}
+
+func (r *queuedRelay) QueuedProposers(_ context.Context) ([]*v1.QueuedProposer, error) {
+	return r.queued, nil
+}
+
+func TestNew(t *testing.T) {
+	_, err := compliance.New(context.Background())
+	require.EqualError(t, err, "problem with parameters: no relays specified")
+
+	_, err = compliance.New(context.Background(),
+		compliance.WithRelays([]client.ValidatorRegistrationProvider{&lookupRelay{}}),
+		compliance.WithMaxRegistrationAge(-time.Second),
+	)
+	require.EqualError(t, err, "problem with parameters: max registration age cannot be negative")
+}
+
+func TestCheckRegistrations(t *testing.T) {
+	feeRecipient := bellatrix.ExecutionAddress{0x01}
+	validator1 := phase0.BLSPubKey{0x01}
+	validator2 := phase0.BLSPubKey{0x02}
+	validator3 := phase0.BLSPubKey{0x03}
+
+	expectations := []*compliance.Expectation{
+		{Pubkey: validator1, FeeRecipient: feeRecipient, GasLimit: 36000000},
+		{Pubkey: validator2, FeeRecipient: feeRecipient, GasLimit: 36000000},
+		{Pubkey: validator3, FeeRecipient: feeRecipient, GasLimit: 36000000},
+	}
+
+	relay1 := &lookupRelay{
+		name: "relay1",
+		registrations: map[phase0.BLSPubKey]*builderv1.ValidatorRegistration{
+			validator1: {Pubkey: validator1, FeeRecipient: feeRecipient, GasLimit: 36000000, Timestamp: time.Now()},
+			validator2: {Pubkey: validator2, FeeRecipient: bellatrix.ExecutionAddress{0x02}, GasLimit: 30000000, Timestamp: time.Now().Add(-48 * time.Hour)},
+		},
+	}
+	relay2 := &queuedRelay{
+		lookupRelay: lookupRelay{
+			name: "relay2",
+			registrations: map[phase0.BLSPubKey]*builderv1.ValidatorRegistration{
+				validator2: {Pubkey: validator2, FeeRecipient: feeRecipient, GasLimit: 36000000, Timestamp: time.Now()},
+			},
+		},
+		queued: []*v1.QueuedProposer{
+			{
+				Slot: 1,
+				Entry: &builderv1.SignedValidatorRegistration{
+					Message: &builderv1.ValidatorRegistration{Pubkey: validator1, FeeRecipient: feeRecipient, GasLimit: 36000000, Timestamp: time.Now()},
+				},
+			},
+		},
+	}
+	relay3 := &lookupRelay{
+		name: "relay3",
+		err:  errors.New("mock error"),
+	}
+
+	s, err := compliance.New(context.Background(),
+		compliance.WithRelays([]client.ValidatorRegistrationProvider{relay1, relay2, relay3}),
+		compliance.WithMaxRegistrationAge(24*time.Hour),
+	)
+	require.NoError(t, err)
+
+	_, err = s.CheckRegistrations(context.Background(), nil)
+	require.EqualError(t, err, "no expectations supplied")
+
+	report, err := s.CheckRegistrations(context.Background(), expectations)
+	require.NoError(t, err)
+	require.False(t, report.Compliant())
+	require.Len(t, report.Results, 9)
+
+	// Validator 1.
+	require.Equal(t, "relay1", report.Results[0].Relay)
+	require.True(t, report.Results[0].Compliant())
+	require.Equal(t, "relay2", report.Results[1].Relay)
+	require.True(t, report.Results[1].Compliant())
+	require.EqualError(t, report.Results[2].Err, "mock error")
+
+	// Validator 2.
+	require.Equal(t, []compliance.Issue{
+		compliance.IssueStale,
+		compliance.IssueFeeRecipientMismatch,
+		compliance.IssueGasLimitMismatch,
+	}, report.Results[3].Issues)
+	require.True(t, report.Results[4].Compliant())
+
+	// Validator 3.
+	require.Equal(t, []compliance.Issue{compliance.IssueMissing}, report.Results[6].Issues)
+	require.Nil(t, report.Results[6].Registration)
+	require.Equal(t, []compliance.Issue{compliance.IssueMissing}, report.Results[7].Issues)
+
+	// Relay 2 should only have looked up the validators not in its queue.
+	require.Equal(t, 2, relay2.lookups)
+
+	var buf bytes.Buffer
+	require.NoError(t, report.WriteTable(&buf))
+	require.Contains(t, buf.String(), "stale, fee recipient mismatch, gas limit mismatch")
+	require.Contains(t, buf.String(), "error: mock error")
+}
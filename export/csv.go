@@ -0,0 +1,78 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"encoding/csv"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// CSVWriter streams records as CSV, with a header row of column names.
+type CSVWriter[T any] struct {
+	writer          *csv.Writer
+	schema          *Schema[T]
+	timestampFormat TimestampFormat
+	wroteHeader     bool
+}
+
+// NewCSVWriter creates a CSV writer for the given schema.
+func NewCSVWriter[T any](w io.Writer, schema *Schema[T], timestampFormat TimestampFormat) *CSVWriter[T] {
+	return &CSVWriter[T]{
+		writer:          csv.NewWriter(w),
+		schema:          schema,
+		timestampFormat: timestampFormat,
+	}
+}
+
+// Write writes a record.
+func (w *CSVWriter[T]) Write(record T) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	fields, err := w.schema.encode(record, w.timestampFormat)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode record")
+	}
+	if err := w.writer.Write(fields); err != nil {
+		return errors.Wrap(err, "failed to write record")
+	}
+
+	return nil
+}
+
+// Flush writes any buffered data to the underlying writer.
+// The header is written even if there are no records.
+func (w *CSVWriter[T]) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.writer.Flush()
+
+	return w.writer.Error()
+}
+
+func (w *CSVWriter[T]) writeHeader() error {
+	if w.wroteHeader {
+		return nil
+	}
+	if err := w.writer.Write(w.schema.columns); err != nil {
+		return errors.Wrap(err, "failed to write header")
+	}
+	w.wroteHeader = true
+
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	builderv1 "github.com/attestantio/go-builder-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/export"
+	"github.com/stretchr/testify/require"
+)
+
+// bigValue is a value that does not fit in a float64 without loss.
+var bigValue, _ = new(big.Int).SetString("123456789012345678901234567", 10)
+
+func testBidTraceWithTimestamp() *v1.BidTraceWithTimestamp {
+	return &v1.BidTraceWithTimestamp{
+		Slot:                 7654321,
+		ParentHash:           phase0.Hash32{0x01},
+		BlockHash:            phase0.Hash32{0x02},
+		BuilderPubkey:        phase0.BLSPubKey{0x03},
+		ProposerPubkey:       phase0.BLSPubKey{0x04},
+		ProposerFeeRecipient: [20]byte{0x05},
+		GasLimit:             36000000,
+		GasUsed:              12345678,
+		Value:                bigValue,
+		Timestamp:            time.UnixMilli(1700000000123),
+	}
+}
+
+func testQueuedProposer() *v1.QueuedProposer {
+	return &v1.QueuedProposer{
+		Slot: 7654321,
+		Entry: &builderv1.SignedValidatorRegistration{
+			Message: &builderv1.ValidatorRegistration{
+				FeeRecipient: [20]byte{0x05},
+				GasLimit:     36000000,
+				Timestamp:    time.UnixMilli(1700000000000),
+				Pubkey:       phase0.BLSPubKey{0x04},
+			},
+			Signature: phase0.BLSSignature{0x06},
+		},
+	}
+}
+
+func TestCSVWriterBidTrace(t *testing.T) {
+	var buf bytes.Buffer
+	w := export.NewCSVWriter(&buf, export.BidTraceSchema, export.TimestampRFC3339)
+	require.NoError(t, w.Flush())
+	require.Equal(t, "slot,parent_hash,block_hash,builder_pubkey,proposer_pubkey,proposer_fee_recipient,gas_limit,gas_used,value\n", buf.String())
+
+	require.EqualError(t, w.Write(&v1.BidTrace{}), "failed to encode record: value missing")
+
+	trace := testBidTraceWithTimestamp()
+	require.NoError(t, w.Write(&v1.BidTrace{
+		Slot:                 trace.Slot,
+		ParentHash:           trace.ParentHash,
+		BlockHash:            trace.BlockHash,
+		BuilderPubkey:        trace.BuilderPubkey,
+		ProposerPubkey:       trace.ProposerPubkey,
+		ProposerFeeRecipient: trace.ProposerFeeRecipient,
+		GasLimit:             trace.GasLimit,
+		GasUsed:              trace.GasUsed,
+		Value:                trace.Value,
+	}))
+	require.NoError(t, w.Flush())
+	require.Contains(t, buf.String(), ",36000000,12345678,123456789012345678901234567\n")
+}
+
+func TestCSVWriterBidTraceWithTimestamp(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   export.TimestampFormat
+		expected string
+	}{
+		{
+			name:     "RFC3339",
+			format:   export.TimestampRFC3339,
+			expected: ",123456789012345678901234567,2023-11-14T22:13:20.123Z\n",
+		},
+		{
+			name:     "Milliseconds",
+			format:   export.TimestampMilliseconds,
+			expected: ",123456789012345678901234567,1700000000123\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := export.NewCSVWriter(&buf, export.BidTraceWithTimestampSchema, test.format)
+			require.NoError(t, w.Write(testBidTraceWithTimestamp()))
+			require.NoError(t, w.Flush())
+			require.Contains(t, buf.String(), "gas_used,value,timestamp\n")
+			require.Contains(t, buf.String(), test.expected)
+		})
+	}
+}
+
+func TestCSVWriterQueuedProposer(t *testing.T) {
+	var buf bytes.Buffer
+	w := export.NewCSVWriter(&buf, export.QueuedProposerSchema, export.TimestampMilliseconds)
+	require.EqualError(t, w.Write(&v1.QueuedProposer{}), "failed to encode record: entry missing")
+	require.NoError(t, w.Write(testQueuedProposer()))
+	require.NoError(t, w.Flush())
+	require.Equal(t, []string{"slot", "pubkey", "fee_recipient", "gas_limit", "timestamp", "signature"}, export.QueuedProposerSchema.Columns())
+	require.Contains(t, buf.String(), "slot,pubkey,fee_recipient,gas_limit,timestamp,signature\n7654321,0x04")
+	require.Contains(t, buf.String(), ",36000000,1700000000000,0x06")
+}
@@ -0,0 +1,134 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// NDJSONWriter streams records as newline-delimited JSON objects.
+// Object keys are the schema's columns, in column order, and all values
+// are strings so that large integers survive intact.
+type NDJSONWriter[T any] struct {
+	writer          *bufio.Writer
+	schema          *Schema[T]
+	timestampFormat TimestampFormat
+	buf             bytes.Buffer
+}
+
+// NewNDJSONWriter creates a newline-delimited JSON writer for the given schema.
+func NewNDJSONWriter[T any](w io.Writer, schema *Schema[T], timestampFormat TimestampFormat) *NDJSONWriter[T] {
+	return &NDJSONWriter[T]{
+		writer:          bufio.NewWriter(w),
+		schema:          schema,
+		timestampFormat: timestampFormat,
+	}
+}
+
+// Write writes a record.
+func (w *NDJSONWriter[T]) Write(record T) error {
+	fields, err := w.schema.encode(record, w.timestampFormat)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode record")
+	}
+
+	// Build the object by hand, as maps do not retain key order.
+	w.buf.Reset()
+	w.buf.WriteByte('{')
+	for i := range fields {
+		if i > 0 {
+			w.buf.WriteByte(',')
+		}
+		key, err := json.Marshal(w.schema.columns[i])
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal key")
+		}
+		value, err := json.Marshal(fields[i])
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal value")
+		}
+		w.buf.Write(key)
+		w.buf.WriteByte(':')
+		w.buf.Write(value)
+	}
+	w.buf.WriteString("}\n")
+
+	if _, err := w.writer.Write(w.buf.Bytes()); err != nil {
+		return errors.Wrap(err, "failed to write record")
+	}
+
+	return nil
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (w *NDJSONWriter[T]) Flush() error {
+	return w.writer.Flush()
+}
+
+// NDJSONReader reads records written by an NDJSONWriter.
+// Timestamps are accepted in either timestamp format.
+type NDJSONReader[T any] struct {
+	reader *bufio.Reader
+	schema *Schema[T]
+	line   int
+}
+
+// NewNDJSONReader creates a newline-delimited JSON reader for the given schema.
+func NewNDJSONReader[T any](r io.Reader, schema *Schema[T]) *NDJSONReader[T] {
+	return &NDJSONReader[T]{
+		reader: bufio.NewReader(r),
+		schema: schema,
+	}
+}
+
+// Read reads the next record.  It returns io.EOF when there are no more records.
+func (r *NDJSONReader[T]) Read() (T, error) {
+	var res T
+	for {
+		data, err := r.reader.ReadBytes('\n')
+		if len(data) == 0 && err != nil {
+			return res, err
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return res, errors.Wrap(err, "failed to read record")
+		}
+		r.line++
+
+		data = bytes.TrimSpace(data)
+		if len(data) == 0 {
+			continue
+		}
+
+		values := make(map[string]string, len(r.schema.columns))
+		if err := json.Unmarshal(data, &values); err != nil {
+			return res, errors.Wrap(err, fmt.Sprintf("invalid JSON on line %d", r.line))
+		}
+		record := make([]string, len(r.schema.columns))
+		for i, column := range r.schema.columns {
+			record[i] = values[column]
+		}
+		res, err = r.schema.decode(record)
+		if err != nil {
+			return res, errors.Wrap(err, fmt.Sprintf("invalid record on line %d", r.line))
+		}
+
+		return res, nil
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-relay-client/export"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNDJSONBidTraceWithTimestamp(t *testing.T) {
+	for _, format := range []export.TimestampFormat{export.TimestampRFC3339, export.TimestampMilliseconds} {
+		t.Run(format.String(), func(t *testing.T) {
+			var buf bytes.Buffer
+			w := export.NewNDJSONWriter(&buf, export.BidTraceWithTimestampSchema, format)
+			trace := testBidTraceWithTimestamp()
+			require.NoError(t, w.Write(trace))
+			require.NoError(t, w.Write(trace))
+			require.NoError(t, w.Flush())
+
+			lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+			require.Len(t, lines, 2)
+			require.True(t, strings.HasPrefix(lines[0], `{"slot":"7654321","parent_hash":"0x01`))
+			require.Contains(t, lines[0], `"value":"123456789012345678901234567"`)
+
+			r := export.NewNDJSONReader(&buf, export.BidTraceWithTimestampSchema)
+			for range 2 {
+				res, err := r.Read()
+				require.NoError(t, err)
+				require.Equal(t, trace.String(), res.String())
+			}
+			_, err := r.Read()
+			require.ErrorIs(t, err, io.EOF)
+		})
+	}
+}
+
+func TestNDJSONQueuedProposer(t *testing.T) {
+	var buf bytes.Buffer
+	w := export.NewNDJSONWriter(&buf, export.QueuedProposerSchema, export.TimestampRFC3339)
+	proposer := testQueuedProposer()
+	require.NoError(t, w.Write(proposer))
+	require.NoError(t, w.Flush())
+
+	res, err := export.NewNDJSONReader(&buf, export.QueuedProposerSchema).Read()
+	require.NoError(t, err)
+	require.Equal(t, proposer.String(), res.String())
+}
+
+func TestNDJSONReader(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "Empty",
+			input: "\n\n",
+			err:   "EOF",
+		},
+		{
+			name:  "InvalidJSON",
+			input: "\n[]\n",
+			err:   "invalid JSON on line 2: json: cannot unmarshal array into Go value of type map[string]string",
+		},
+		{
+			name:  "SlotMissing",
+			input: `{"parent_hash":"0x01"}`,
+			err:   "invalid record on line 1: slot missing",
+		},
+		{
+			name:  "BlockHashWrongLength",
+			input: `{"slot":"1","parent_hash":"0x0100000000000000000000000000000000000000000000000000000000000000","block_hash":"0x01"}`,
+			err:   "invalid record on line 1: incorrect length for block hash",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := export.NewNDJSONReader(strings.NewReader(test.input), export.BidTraceSchema).Read()
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
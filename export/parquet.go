@@ -0,0 +1,151 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"io"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/compress"
+	"github.com/pkg/errors"
+)
+
+// bidTraceRow is the Parquet representation of a delivered bid trace.
+// Values are decimal strings, as wei amounts can exceed the range of the
+// Parquet decimal type.
+type bidTraceRow struct {
+	Slot                 uint64   `parquet:"slot"`
+	ParentHash           [32]byte `parquet:"parent_hash"`
+	BlockHash            [32]byte `parquet:"block_hash"`
+	BuilderPubkey        [48]byte `parquet:"builder_pubkey"`
+	ProposerPubkey       [48]byte `parquet:"proposer_pubkey"`
+	ProposerFeeRecipient [20]byte `parquet:"proposer_fee_recipient"`
+	GasLimit             uint64   `parquet:"gas_limit"`
+	GasUsed              uint64   `parquet:"gas_used"`
+	Value                string   `parquet:"value"`
+}
+
+// bidTraceWithTimestampRow is the Parquet representation of a received bid trace.
+type bidTraceWithTimestampRow struct {
+	Slot                 uint64   `parquet:"slot"`
+	ParentHash           [32]byte `parquet:"parent_hash"`
+	BlockHash            [32]byte `parquet:"block_hash"`
+	BuilderPubkey        [48]byte `parquet:"builder_pubkey"`
+	ProposerPubkey       [48]byte `parquet:"proposer_pubkey"`
+	ProposerFeeRecipient [20]byte `parquet:"proposer_fee_recipient"`
+	GasLimit             uint64   `parquet:"gas_limit"`
+	GasUsed              uint64   `parquet:"gas_used"`
+	Value                string   `parquet:"value"`
+	Timestamp            int64    `parquet:"timestamp,timestamp(millisecond)"`
+}
+
+// queuedProposerRow is the Parquet representation of a queued proposer.
+type queuedProposerRow struct {
+	Slot         uint64   `parquet:"slot"`
+	Pubkey       [48]byte `parquet:"pubkey"`
+	FeeRecipient [20]byte `parquet:"fee_recipient"`
+	GasLimit     uint64   `parquet:"gas_limit"`
+	Timestamp    int64    `parquet:"timestamp,timestamp(millisecond)"`
+	Signature    [96]byte `parquet:"signature"`
+}
+
+type parquetParameters struct {
+	rowGroupSize int64
+	compression  compress.Codec
+}
+
+// ParquetParameter is the interface for Parquet writer parameters.
+type ParquetParameter interface {
+	apply(*parquetParameters)
+}
+
+type parquetParameterFunc func(*parquetParameters)
+
+func (f parquetParameterFunc) apply(p *parquetParameters) {
+	f(p)
+}
+
+// WithRowGroupSize sets the maximum number of rows in each row group.
+func WithRowGroupSize(rows int64) ParquetParameter {
+	return parquetParameterFunc(func(p *parquetParameters) {
+		p.rowGroupSize = rows
+	})
+}
+
+// WithCompression sets the compression codec for the columns.
+func WithCompression(codec compress.Codec) ParquetParameter {
+	return parquetParameterFunc(func(p *parquetParameters) {
+		p.compression = codec
+	})
+}
+
+// ParquetWriter streams records as a Parquet file.
+// Close must be called once all records are written to complete the file.
+type ParquetWriter[T any] struct {
+	writer *parquet.Writer
+	schema *Schema[T]
+}
+
+// NewParquetWriter creates a Parquet writer for the given schema.
+// By default row groups hold up to 1,000,000 rows and columns are
+// compressed with zstd.
+func NewParquetWriter[T any](w io.Writer, schema *Schema[T], params ...ParquetParameter) (*ParquetWriter[T], error) {
+	parameters := parquetParameters{
+		rowGroupSize: 1000000,
+		compression:  &parquet.Zstd,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+	if parameters.rowGroupSize <= 0 {
+		return nil, errors.New("row group size must be positive")
+	}
+	if parameters.compression == nil {
+		return nil, errors.New("no compression specified")
+	}
+
+	return &ParquetWriter[T]{
+		writer: parquet.NewWriter(w,
+			schema.parquetSchema,
+			parquet.MaxRowsPerRowGroup(parameters.rowGroupSize),
+			parquet.Compression(parameters.compression),
+		),
+		schema: schema,
+	}, nil
+}
+
+// Write writes a record.
+func (w *ParquetWriter[T]) Write(record T) error {
+	row, err := w.schema.parquetRow(record)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode record")
+	}
+	if err := w.writer.Write(row); err != nil {
+		return errors.Wrap(err, "failed to write record")
+	}
+
+	return nil
+}
+
+// Flush ends the current row group, writing it to the underlying writer.
+func (w *ParquetWriter[T]) Flush() error {
+	return w.writer.Flush()
+}
+
+// Close writes any remaining rows and the file footer.
+func (w *ParquetWriter[T]) Close() error {
+	return w.writer.Close()
+}
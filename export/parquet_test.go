@@ -0,0 +1,100 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/export"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+)
+
+// receivedBid mirrors the exported Parquet row for received bids.
+type receivedBid struct {
+	Slot          uint64   `parquet:"slot"`
+	BlockHash     [32]byte `parquet:"block_hash"`
+	BuilderPubkey [48]byte `parquet:"builder_pubkey"`
+	Value         string   `parquet:"value"`
+	Timestamp     int64    `parquet:"timestamp"`
+}
+
+func TestParquetWriter(t *testing.T) {
+	_, err := export.NewParquetWriter(io.Discard, export.BidTraceSchema, export.WithRowGroupSize(0))
+	require.EqualError(t, err, "row group size must be positive")
+
+	var buf bytes.Buffer
+	w, err := export.NewParquetWriter(&buf, export.BidTraceWithTimestampSchema, export.WithRowGroupSize(2))
+	require.NoError(t, err)
+
+	require.EqualError(t, w.Write(&v1.BidTraceWithTimestamp{}), "failed to encode record: value missing")
+
+	trace := testBidTraceWithTimestamp()
+	for range 5 {
+		require.NoError(t, w.Write(trace))
+	}
+	require.NoError(t, w.Close())
+
+	file, err := parquet.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Equal(t, int64(5), file.NumRows())
+	require.Len(t, file.RowGroups(), 3)
+
+	schema := file.Schema()
+	for _, column := range []struct {
+		name     string
+		expected string
+	}{
+		{name: "slot", expected: "INT(64,false)"},
+		{name: "block_hash", expected: "FIXED_LEN_BYTE_ARRAY(32)"},
+		{name: "builder_pubkey", expected: "FIXED_LEN_BYTE_ARRAY(48)"},
+		{name: "proposer_fee_recipient", expected: "FIXED_LEN_BYTE_ARRAY(20)"},
+		{name: "value", expected: "STRING"},
+		{name: "timestamp", expected: "TIMESTAMP(isAdjustedToUTC=true,unit=MILLIS)"},
+	} {
+		field, exists := schema.Lookup(column.name)
+		require.True(t, exists, column.name)
+		require.Equal(t, column.expected, field.Node.Type().String(), column.name)
+	}
+
+	rows := make([]receivedBid, 5)
+	n, err := parquet.NewGenericReader[receivedBid](bytes.NewReader(buf.Bytes())).Read(rows)
+	if err != nil {
+		require.ErrorIs(t, err, io.EOF)
+	}
+	require.Equal(t, 5, n)
+	require.Equal(t, uint64(trace.Slot), rows[4].Slot)
+	require.Equal(t, [32]byte(trace.BlockHash), rows[4].BlockHash)
+	require.Equal(t, trace.Value.String(), rows[4].Value)
+	require.Equal(t, trace.Timestamp.UnixMilli(), rows[4].Timestamp)
+}
+
+func TestParquetWriterQueuedProposer(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := export.NewParquetWriter(&buf, export.QueuedProposerSchema)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(testQueuedProposer()))
+	require.NoError(t, w.Flush())
+	require.NoError(t, w.Close())
+
+	file, err := parquet.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), file.NumRows())
+	field, exists := file.Schema().Lookup("signature")
+	require.True(t, exists)
+	require.Equal(t, "FIXED_LEN_BYTE_ARRAY(96)", field.Node.Type().String())
+}
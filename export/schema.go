@@ -0,0 +1,360 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package export writes relay data in formats suitable for loading into other systems.
+package export
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	builderv1 "github.com/attestantio/go-builder-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/parquet-go/parquet-go"
+	"github.com/pkg/errors"
+)
+
+// TimestampFormat is the format in which timestamps are exported.
+type TimestampFormat int
+
+const (
+	// TimestampRFC3339 exports timestamps as RFC 3339 with millisecond precision, in UTC.
+	TimestampRFC3339 TimestampFormat = iota
+	// TimestampMilliseconds exports timestamps as milliseconds since the Unix epoch.
+	TimestampMilliseconds
+)
+
+var timestampFormatStrings = [...]string{
+	"rfc3339",
+	"milliseconds",
+}
+
+// String returns a string representation of the timestamp format.
+func (f TimestampFormat) String() string {
+	if int(f) >= len(timestampFormatStrings) {
+		return timestampFormatStrings[0]
+	}
+
+	return timestampFormatStrings[f]
+}
+
+// rfc3339Milli is RFC 3339 with a fixed millisecond component, so that
+// exported timestamps sort lexically.
+const rfc3339Milli = "2006-01-02T15:04:05.000Z07:00"
+
+// Schema defines the columns of an exported record type, and how records
+// of that type map to and from them.
+type Schema[T any] struct {
+	columns       []string
+	encode        func(T, TimestampFormat) ([]string, error)
+	decode        func([]string) (T, error)
+	parquetSchema *parquet.Schema
+	parquetRow    func(T) (any, error)
+}
+
+// Columns returns the names of the columns, in order.
+func (s *Schema[T]) Columns() []string {
+	return append([]string{}, s.columns...)
+}
+
+// BidTraceSchema is the schema for delivered bid traces.
+var BidTraceSchema = &Schema[*v1.BidTrace]{
+	columns: []string{
+		"slot",
+		"parent_hash",
+		"block_hash",
+		"builder_pubkey",
+		"proposer_pubkey",
+		"proposer_fee_recipient",
+		"gas_limit",
+		"gas_used",
+		"value",
+	},
+	encode: func(trace *v1.BidTrace, _ TimestampFormat) ([]string, error) {
+		if trace.Value == nil {
+			return nil, errors.New("value missing")
+		}
+
+		return []string{
+			fmt.Sprintf("%d", trace.Slot),
+			fmt.Sprintf("%#x", trace.ParentHash),
+			fmt.Sprintf("%#x", trace.BlockHash),
+			fmt.Sprintf("%#x", trace.BuilderPubkey),
+			fmt.Sprintf("%#x", trace.ProposerPubkey),
+			fmt.Sprintf("%#x", trace.ProposerFeeRecipient),
+			fmt.Sprintf("%d", trace.GasLimit),
+			fmt.Sprintf("%d", trace.GasUsed),
+			trace.Value.String(),
+		}, nil
+	},
+	decode: func(record []string) (*v1.BidTrace, error) {
+		trace := &v1.BidTrace{}
+		d := &decoder{record: record}
+		trace.Slot = phase0.Slot(d.uint64(0, "slot"))
+		d.bytes(1, "parent hash", trace.ParentHash[:])
+		d.bytes(2, "block hash", trace.BlockHash[:])
+		d.bytes(3, "builder pubkey", trace.BuilderPubkey[:])
+		d.bytes(4, "proposer pubkey", trace.ProposerPubkey[:])
+		d.bytes(5, "proposer fee recipient", trace.ProposerFeeRecipient[:])
+		trace.GasLimit = d.uint64(6, "gas limit")
+		trace.GasUsed = d.uint64(7, "gas used")
+		trace.Value = d.bigInt(8, "value")
+		if d.err != nil {
+			return nil, d.err
+		}
+
+		return trace, nil
+	},
+	parquetSchema: parquet.SchemaOf(bidTraceRow{}),
+	parquetRow: func(trace *v1.BidTrace) (any, error) {
+		if trace.Value == nil {
+			return nil, errors.New("value missing")
+		}
+
+		return &bidTraceRow{
+			Slot:                 uint64(trace.Slot),
+			ParentHash:           trace.ParentHash,
+			BlockHash:            trace.BlockHash,
+			BuilderPubkey:        trace.BuilderPubkey,
+			ProposerPubkey:       trace.ProposerPubkey,
+			ProposerFeeRecipient: trace.ProposerFeeRecipient,
+			GasLimit:             trace.GasLimit,
+			GasUsed:              trace.GasUsed,
+			Value:                trace.Value.String(),
+		}, nil
+	},
+}
+
+// BidTraceWithTimestampSchema is the schema for received bid traces.
+var BidTraceWithTimestampSchema = &Schema[*v1.BidTraceWithTimestamp]{
+	columns: []string{
+		"slot",
+		"parent_hash",
+		"block_hash",
+		"builder_pubkey",
+		"proposer_pubkey",
+		"proposer_fee_recipient",
+		"gas_limit",
+		"gas_used",
+		"value",
+		"timestamp",
+	},
+	encode: func(trace *v1.BidTraceWithTimestamp, format TimestampFormat) ([]string, error) {
+		if trace.Value == nil {
+			return nil, errors.New("value missing")
+		}
+
+		return []string{
+			fmt.Sprintf("%d", trace.Slot),
+			fmt.Sprintf("%#x", trace.ParentHash),
+			fmt.Sprintf("%#x", trace.BlockHash),
+			fmt.Sprintf("%#x", trace.BuilderPubkey),
+			fmt.Sprintf("%#x", trace.ProposerPubkey),
+			fmt.Sprintf("%#x", trace.ProposerFeeRecipient),
+			fmt.Sprintf("%d", trace.GasLimit),
+			fmt.Sprintf("%d", trace.GasUsed),
+			trace.Value.String(),
+			formatTimestamp(trace.Timestamp, format),
+		}, nil
+	},
+	decode: func(record []string) (*v1.BidTraceWithTimestamp, error) {
+		trace := &v1.BidTraceWithTimestamp{}
+		d := &decoder{record: record}
+		trace.Slot = phase0.Slot(d.uint64(0, "slot"))
+		d.bytes(1, "parent hash", trace.ParentHash[:])
+		d.bytes(2, "block hash", trace.BlockHash[:])
+		d.bytes(3, "builder pubkey", trace.BuilderPubkey[:])
+		d.bytes(4, "proposer pubkey", trace.ProposerPubkey[:])
+		d.bytes(5, "proposer fee recipient", trace.ProposerFeeRecipient[:])
+		trace.GasLimit = d.uint64(6, "gas limit")
+		trace.GasUsed = d.uint64(7, "gas used")
+		trace.Value = d.bigInt(8, "value")
+		trace.Timestamp = d.timestamp(9, "timestamp")
+		if d.err != nil {
+			return nil, d.err
+		}
+
+		return trace, nil
+	},
+	parquetSchema: parquet.SchemaOf(bidTraceWithTimestampRow{}),
+	parquetRow: func(trace *v1.BidTraceWithTimestamp) (any, error) {
+		if trace.Value == nil {
+			return nil, errors.New("value missing")
+		}
+
+		return &bidTraceWithTimestampRow{
+			Slot:                 uint64(trace.Slot),
+			ParentHash:           trace.ParentHash,
+			BlockHash:            trace.BlockHash,
+			BuilderPubkey:        trace.BuilderPubkey,
+			ProposerPubkey:       trace.ProposerPubkey,
+			ProposerFeeRecipient: trace.ProposerFeeRecipient,
+			GasLimit:             trace.GasLimit,
+			GasUsed:              trace.GasUsed,
+			Value:                trace.Value.String(),
+			Timestamp:            trace.Timestamp.UnixMilli(),
+		}, nil
+	},
+}
+
+// QueuedProposerSchema is the schema for queued proposers.
+var QueuedProposerSchema = &Schema[*v1.QueuedProposer]{
+	columns: []string{
+		"slot",
+		"pubkey",
+		"fee_recipient",
+		"gas_limit",
+		"timestamp",
+		"signature",
+	},
+	encode: func(proposer *v1.QueuedProposer, format TimestampFormat) ([]string, error) {
+		if proposer.Entry == nil || proposer.Entry.Message == nil {
+			return nil, errors.New("entry missing")
+		}
+
+		return []string{
+			fmt.Sprintf("%d", proposer.Slot),
+			fmt.Sprintf("%#x", proposer.Entry.Message.Pubkey),
+			fmt.Sprintf("%#x", proposer.Entry.Message.FeeRecipient),
+			fmt.Sprintf("%d", proposer.Entry.Message.GasLimit),
+			formatTimestamp(proposer.Entry.Message.Timestamp, format),
+			fmt.Sprintf("%#x", proposer.Entry.Signature),
+		}, nil
+	},
+	decode: func(record []string) (*v1.QueuedProposer, error) {
+		proposer := &v1.QueuedProposer{
+			Entry: &builderv1.SignedValidatorRegistration{
+				Message: &builderv1.ValidatorRegistration{},
+			},
+		}
+		d := &decoder{record: record}
+		proposer.Slot = phase0.Slot(d.uint64(0, "slot"))
+		d.bytes(1, "pubkey", proposer.Entry.Message.Pubkey[:])
+		d.bytes(2, "fee recipient", proposer.Entry.Message.FeeRecipient[:])
+		proposer.Entry.Message.GasLimit = d.uint64(3, "gas limit")
+		proposer.Entry.Message.Timestamp = d.timestamp(4, "timestamp")
+		d.bytes(5, "signature", proposer.Entry.Signature[:])
+		if d.err != nil {
+			return nil, d.err
+		}
+
+		return proposer, nil
+	},
+	parquetSchema: parquet.SchemaOf(queuedProposerRow{}),
+	parquetRow: func(proposer *v1.QueuedProposer) (any, error) {
+		if proposer.Entry == nil || proposer.Entry.Message == nil {
+			return nil, errors.New("entry missing")
+		}
+
+		return &queuedProposerRow{
+			Slot:         uint64(proposer.Slot),
+			Pubkey:       proposer.Entry.Message.Pubkey,
+			FeeRecipient: proposer.Entry.Message.FeeRecipient,
+			GasLimit:     proposer.Entry.Message.GasLimit,
+			Timestamp:    proposer.Entry.Message.Timestamp.UnixMilli(),
+			Signature:    proposer.Entry.Signature,
+		}, nil
+	},
+}
+
+// formatTimestamp formats a timestamp.
+func formatTimestamp(timestamp time.Time, format TimestampFormat) string {
+	if format == TimestampMilliseconds {
+		return strconv.FormatInt(timestamp.UnixMilli(), 10)
+	}
+
+	return timestamp.UTC().Format(rfc3339Milli)
+}
+
+// decoder decodes the fields of a record, retaining the first error.
+type decoder struct {
+	record []string
+	err    error
+}
+
+func (d *decoder) field(index int, name string) (string, bool) {
+	if d.err != nil {
+		return "", false
+	}
+	if index >= len(d.record) || d.record[index] == "" {
+		d.err = fmt.Errorf("%s missing", name)
+		return "", false
+	}
+
+	return d.record[index], true
+}
+
+func (d *decoder) uint64(index int, name string) uint64 {
+	input, ok := d.field(index, name)
+	if !ok {
+		return 0
+	}
+	res, err := strconv.ParseUint(input, 10, 64)
+	if err != nil {
+		d.err = errors.Wrap(err, fmt.Sprintf("invalid value for %s", name))
+	}
+
+	return res
+}
+
+func (d *decoder) bigInt(index int, name string) *big.Int {
+	input, ok := d.field(index, name)
+	if !ok {
+		return nil
+	}
+	res, success := new(big.Int).SetString(input, 10)
+	if !success {
+		d.err = fmt.Errorf("invalid value for %s", name)
+	}
+
+	return res
+}
+
+func (d *decoder) bytes(index int, name string, dst []byte) {
+	input, ok := d.field(index, name)
+	if !ok {
+		return
+	}
+	data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		d.err = errors.Wrap(err, fmt.Sprintf("invalid value for %s", name))
+		return
+	}
+	if len(data) != len(dst) {
+		d.err = fmt.Errorf("incorrect length for %s", name)
+		return
+	}
+	copy(dst, data)
+}
+
+// timestamp decodes a timestamp in either of the supported formats.
+func (d *decoder) timestamp(index int, name string) time.Time {
+	input, ok := d.field(index, name)
+	if !ok {
+		return time.Time{}
+	}
+	if ms, err := strconv.ParseInt(input, 10, 64); err == nil {
+		return time.UnixMilli(ms)
+	}
+	res, err := time.Parse(time.RFC3339Nano, input)
+	if err != nil {
+		d.err = errors.Wrap(err, fmt.Sprintf("invalid value for %s", name))
+	}
+
+	return res
+}
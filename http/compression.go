@@ -0,0 +1,110 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+const (
+	// EncodingGzip is the gzip content encoding.
+	EncodingGzip = "gzip"
+	// EncodingZstd is the zstd content encoding.
+	EncodingZstd = "zstd"
+	// EncodingBrotli is the brotli content encoding.
+	EncodingBrotli = "br"
+)
+
+// errLimitExceeded is returned when data exceeds the maximum response size.
+var errLimitExceeded = errors.New("limit exceeded")
+
+// supportedEncoding returns true if the content encoding is supported.
+func supportedEncoding(encoding string) bool {
+	switch encoding {
+	case EncodingGzip, EncodingZstd, EncodingBrotli:
+		return true
+	default:
+		return false
+	}
+}
+
+// readAll reads all data from the reader, returning errLimitExceeded if there
+// is more than limit bytes.  A limit of 0 means no limit.
+func readAll(reader io.Reader, limit int64) ([]byte, error) {
+	if limit == 0 {
+		return io.ReadAll(reader)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(reader, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, errLimitExceeded
+	}
+
+	return data, nil
+}
+
+// decodeContent decodes data with the given content encoding, returning
+// errLimitExceeded if the decoded data is more than limit bytes.  A limit of
+// 0 means no limit.
+func decodeContent(encoding string, data []byte, limit int64) ([]byte, error) {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "", "identity":
+		return data, nil
+	case EncodingGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid gzip data")
+		}
+		defer reader.Close()
+
+		return decodedData(reader, limit, "invalid gzip data")
+	case EncodingZstd:
+		reader, err := zstd.NewReader(bytes.NewReader(data), zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid zstd data")
+		}
+		defer reader.Close()
+
+		return decodedData(reader, limit, "invalid zstd data")
+	case EncodingBrotli:
+		return decodedData(brotli.NewReader(bytes.NewReader(data)), limit, "invalid brotli data")
+	default:
+		return nil, fmt.Errorf("unsupported content encoding %s", encoding)
+	}
+}
+
+// decodedData reads decoded data from the reader.
+func decodedData(reader io.Reader, limit int64, msg string) ([]byte, error) {
+	data, err := readAll(reader, limit)
+	if err != nil {
+		if errors.Is(err, errLimitExceeded) {
+			return nil, err
+		}
+
+		return nil, errors.Wrap(err, msg)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,163 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/andybalholm/brotli"
+	client "github.com/attestantio/go-relay-client"
+	"github.com/attestantio/go-relay-client/http"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+)
+
+// encodingServer encodes responses with the first encoding it is offered.
+type encodingServer struct {
+	mu             sync.Mutex
+	acceptEncoding string
+}
+
+func (s *encodingServer) ServeHTTP(w nethttp.ResponseWriter, r *nethttp.Request) {
+	s.mu.Lock()
+	s.acceptEncoding = r.Header.Get("Accept-Encoding")
+	s.mu.Unlock()
+
+	if r.URL.Path != "/relay/v1/builder/validators" {
+		nethttp.NotFound(w, r)
+		return
+	}
+
+	data := []byte(`[]`)
+	encoding, _, _ := strings.Cut(s.acceptEncoding, ",")
+	var buf bytes.Buffer
+	switch encoding {
+	case http.EncodingGzip:
+		writer := gzip.NewWriter(&buf)
+		_, _ = writer.Write(data)
+		_ = writer.Close()
+	case http.EncodingZstd:
+		writer, _ := zstd.NewWriter(&buf)
+		_, _ = writer.Write(data)
+		_ = writer.Close()
+	case http.EncodingBrotli:
+		writer := brotli.NewWriter(&buf)
+		_, _ = writer.Write(data)
+		_ = writer.Close()
+	default:
+		buf.Write(data)
+		encoding = ""
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if encoding != "" {
+		w.Header().Set("Content-Encoding", encoding)
+	}
+	_, _ = w.Write(buf.Bytes())
+}
+
+func (s *encodingServer) offered() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.acceptEncoding
+}
+
+func TestContentEncodings(t *testing.T) {
+	ctx := context.Background()
+	handler := &encodingServer{}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		encodings []string
+		offered   string
+		err       string
+	}{
+		{
+			name:    "Default",
+			offered: "gzip",
+		},
+		{
+			name:      "Empty",
+			encodings: []string{},
+		},
+		{
+			name:      "Zstd",
+			encodings: []string{http.EncodingZstd, http.EncodingGzip},
+			offered:   "zstd, gzip",
+		},
+		{
+			name:      "Brotli",
+			encodings: []string{http.EncodingBrotli},
+			offered:   "br",
+		},
+		{
+			name:      "Unsupported",
+			encodings: []string{"deflate"},
+			err:       "problem with parameters: unsupported content encoding deflate",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := []http.Parameter{
+				http.WithTimeout(5 * time.Second),
+				http.WithAddress(server.URL),
+			}
+			if test.encodings != nil {
+				params = append(params, http.WithContentEncodings(test.encodings))
+			}
+			service, err := http.New(ctx, params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+
+			proposers, err := service.(client.QueuedProposersProvider).QueuedProposers(ctx)
+			require.NoError(t, err)
+			require.Empty(t, proposers)
+			require.Equal(t, test.offered, handler.offered())
+		})
+	}
+}
+
+func TestCorruptContent(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", http.EncodingGzip)
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(5*time.Second),
+		http.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = service.(client.QueuedProposersProvider).QueuedProposers(ctx)
+	require.ErrorContains(t, err, "failed to decode GET response: invalid gzip data")
+}
@@ -0,0 +1,339 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// field describes a field of a JSON object for strict decoding.
+type field struct {
+	required bool
+	// check checks the value of the field.  Unused if fields is set.
+	check func(value json.RawMessage) error
+	// fields describes the fields of a nested object.
+	fields schema
+}
+
+// schema describes the fields of a JSON object, keyed by name.
+type schema map[string]*field
+
+// with returns a copy of the schema with additional fields.
+func (s schema) with(fields schema) schema {
+	res := maps.Clone(s)
+	maps.Copy(res, fields)
+
+	return res
+}
+
+var bidTraceSchema = schema{
+	"slot":                   {required: true, check: checkUint64},
+	"parent_hash":            {required: true, check: checkHex(phase0.RootLength)},
+	"block_hash":             {required: true, check: checkHex(phase0.RootLength)},
+	"builder_pubkey":         {required: true, check: checkHex(phase0.PublicKeyLength)},
+	"proposer_pubkey":        {required: true, check: checkHex(phase0.PublicKeyLength)},
+	"proposer_fee_recipient": {required: true, check: checkHex(bellatrix.FeeRecipientLength)},
+	"gas_limit":              {required: true, check: checkUint64},
+	"gas_used":               {required: true, check: checkUint64},
+	"value":                  {required: true, check: checkUint256},
+	// Provided by relays, although not held in bid traces.
+	"block_number": {check: checkUint64},
+	"num_tx":       {check: checkUint64},
+}
+
+var bidTraceWithTimestampSchema = bidTraceSchema.with(schema{
+	// Either timestamp is sufficient; older relays provide an unquoted timestamp.
+	"timestamp":             {check: checkTimestamp},
+	"timestamp_ms":          {check: checkUint64},
+	"optimistic_submission": {check: checkBool},
+})
+
+var queuedProposerSchema = schema{
+	"slot":            {required: true, check: checkUint64},
+	"validator_index": {check: checkUint64},
+	"entry": {required: true, fields: schema{
+		"message": {required: true, fields: schema{
+			"fee_recipient": {required: true, check: checkHex(bellatrix.FeeRecipientLength)},
+			"gas_limit":     {required: true, check: checkUint64},
+			"timestamp":     {required: true, check: checkUint64},
+			"pubkey":        {required: true, check: checkHex(phase0.PublicKeyLength)},
+		}},
+		"signature": {required: true, check: checkHex(phase0.SignatureLength)},
+	}},
+}
+
+// decodingMode is the mode in which records in responses are decoded.
+type decodingMode int
+
+const (
+	// decodingStandard fails if any record cannot be decoded.
+	decodingStandard decodingMode = iota
+	// decodingStrict also checks records against their schema.
+	decodingStrict
+	// decodingLenient skips records that cannot be decoded.
+	decodingLenient
+)
+
+// decodeRecords decodes a JSON array of records.
+// In strict mode each record is checked against the schema before it is
+// decoded, and failures are returned as a DecodeError.
+// In lenient mode records that cannot be decoded are skipped, and returned
+// as DecodeErrors alongside the records that could be decoded.
+func decodeRecords[T any](reader io.Reader, mode decodingMode, recordSchema schema) ([]T, []*DecodeError, error) {
+	res := make([]T, 0)
+	if mode == decodingStandard {
+		if err := json.NewDecoder(reader).Decode(&res); err != nil {
+			return nil, nil, err
+		}
+
+		return res, nil, nil
+	}
+
+	decoder := json.NewDecoder(reader)
+	if err := expectDelim(decoder, '['); err != nil {
+		return nil, nil, err
+	}
+	var skipped []*DecodeError
+	for index := 0; decoder.More(); index++ {
+		var data json.RawMessage
+		if err := decoder.Decode(&data); err != nil {
+			// The array itself is malformed, so no further records can be read.
+			return nil, nil, &DecodeError{Index: index, Err: err}
+		}
+		if mode == decodingStrict {
+			if name, err := checkObject(data, recordSchema); err != nil {
+				return nil, nil, &DecodeError{Index: index, Field: name, Data: data, Err: err}
+			}
+		}
+		record, err := decodeRecord[T](data)
+		if err != nil {
+			decodeErr := &DecodeError{Index: index, Data: data, Err: err}
+			if mode != decodingLenient {
+				return nil, nil, decodeErr
+			}
+			skipped = append(skipped, decodeErr)
+
+			continue
+		}
+		res = append(res, record)
+	}
+	if err := expectDelim(decoder, ']'); err != nil {
+		return nil, nil, err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, nil, errors.New("unexpected data after records")
+	}
+
+	return res, skipped, nil
+}
+
+// decodeRecord decodes a single record, which cannot be null.
+func decodeRecord[T any](data json.RawMessage) (T, error) {
+	var record T
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return record, errors.New("record is null")
+	}
+	err := json.Unmarshal(data, &record)
+
+	return record, err
+}
+
+// decodeAllRecords decodes a JSON array of records as per decodeRecords, but
+// returns an error rather than skipping a record that cannot be decoded.  It
+// is used where a skipped record would be mistaken for the absence of data.
+func decodeAllRecords[T any](reader io.Reader, mode decodingMode, recordSchema schema) ([]T, error) {
+	res, skipped, err := decodeRecords[T](reader, mode, recordSchema)
+	if err != nil {
+		return nil, err
+	}
+	if len(skipped) > 0 {
+		return nil, skipped[0]
+	}
+
+	return res, nil
+}
+
+// logSkippedRecords logs records that were skipped by lenient decoding.
+func logSkippedRecords(endpoint string, skipped []*DecodeError) {
+	for _, decodeErr := range skipped {
+		log.Warn().Str("endpoint", endpoint).Int("index", decodeErr.Index).RawJSON("data", decodeErr.Data).Err(decodeErr.Err).Msg("Skipped record that could not be decoded")
+	}
+}
+
+// checkObject checks a JSON object against the schema.  If the check fails
+// it returns the name of the failing field, if known, along with the error.
+func checkObject(data json.RawMessage, objectSchema schema) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if err := expectDelim(decoder, '{'); err != nil {
+		return "", err
+	}
+
+	seen := make(map[string]bool, len(objectSchema))
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", err
+		}
+		// Object keys are always strings.
+		name, _ := token.(string)
+		if seen[name] {
+			return name, errors.New("duplicate field")
+		}
+		seen[name] = true
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return name, err
+		}
+		objectField, exists := objectSchema[name]
+		switch {
+		case !exists:
+			return name, errors.New("unknown field")
+		case objectField.fields != nil:
+			nested, err := checkObject(value, objectField.fields)
+			if err != nil {
+				if nested == "" {
+					return name, err
+				}
+
+				return fmt.Sprintf("%s.%s", name, nested), err
+			}
+		default:
+			if err := objectField.check(value); err != nil {
+				return name, err
+			}
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(objectSchema)) {
+		if objectSchema[name].required && !seen[name] {
+			return name, errors.New("missing")
+		}
+	}
+
+	return "", nil
+}
+
+// expectDelim reads the next token, which must be the given delimiter.
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %v", delim)
+	}
+
+	return nil
+}
+
+// stringValue returns the value of a JSON string.
+func stringValue(value json.RawMessage) (string, error) {
+	var res string
+	if err := json.Unmarshal(value, &res); err != nil {
+		return "", errors.New("expected string")
+	}
+
+	return res, nil
+}
+
+// checkDecimal checks that the value is a string of decimal digits.
+func checkDecimal(value json.RawMessage) (string, error) {
+	res, err := stringValue(value)
+	if err != nil {
+		return "", err
+	}
+	if res == "" || strings.TrimLeft(res, "0123456789") != "" {
+		return "", errors.New("expected decimal integer")
+	}
+
+	return res, nil
+}
+
+// checkUint64 checks that the value is a decimal uint64.
+func checkUint64(value json.RawMessage) error {
+	str, err := checkDecimal(value)
+	if err != nil {
+		return err
+	}
+	if _, err := strconv.ParseUint(str, 10, 64); err != nil {
+		return errors.New("value out of range")
+	}
+
+	return nil
+}
+
+// checkUint256 checks that the value is a decimal uint256.
+func checkUint256(value json.RawMessage) error {
+	str, err := checkDecimal(value)
+	if err != nil {
+		return err
+	}
+	if _, err := uint256.FromDecimal(str); err != nil {
+		return errors.New("value out of range")
+	}
+
+	return nil
+}
+
+// checkTimestamp checks that the value is a uint64, quoted or not.
+func checkTimestamp(value json.RawMessage) error {
+	if bytes.HasPrefix(value, []byte(`"`)) {
+		return checkUint64(value)
+	}
+	if _, err := strconv.ParseUint(string(value), 10, 64); err != nil {
+		return errors.New("expected integer")
+	}
+
+	return nil
+}
+
+// checkBool checks that the value is a boolean.
+func checkBool(value json.RawMessage) error {
+	var res bool
+	if err := json.Unmarshal(value, &res); err != nil {
+		return errors.New("expected boolean")
+	}
+
+	return nil
+}
+
+// checkHex checks that the value is 0x-prefixed hex of the given length.
+func checkHex(length int) func(value json.RawMessage) error {
+	return func(value json.RawMessage) error {
+		str, err := stringValue(value)
+		if err != nil {
+			return err
+		}
+		data, err := hex.DecodeString(strings.TrimPrefix(str, "0x"))
+		if !strings.HasPrefix(str, "0x") || err != nil || len(data) != length {
+			return fmt.Errorf("expected %d bytes of 0x-prefixed hex", length)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,340 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	"github.com/attestantio/go-relay-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	receivedBidTraceJSON = `{"slot":"1","parent_hash":"0x6cd0618e3e13b751506264263b09979e461e35dec0dfbac20d81ece99a43b9dc","block_hash":"0x4c4f7e0a46a4f8b010bc7f899c949b5b9c0c58d510b6a5b46eda48d796a469ed","builder_pubkey":"0xa1dead01e65f0a0eee7b5170223f20c8f0cbf122eac3324d61afbdb33a8885ff8cab2ef514ac2c7698ae0d6289ef27fc","proposer_pubkey":"0x897d53adc5f6993166720dd365f924c0400a61be59cb53589009b8c3ba571032ca319de34e0459f6fcc8734e35a84fd0","proposer_fee_recipient":"0x32a6bcae2dd28f85555467d85600f4ecc8172808","gas_limit":"30000000","gas_used":"12077817","value":"%s","block_number":"15000000","num_tx":"100","timestamp":"1663144444","timestamp_ms":"1663144444123"%s}`
+	queuedProposerJSON   = `{"slot":"1","validator_index":"1","entry":{"message":{"fee_recipient":"0x388Ea662EF2c223eC0B047D41Bf3c0f362142ad5","gas_limit":"30000000","timestamp":"1663144444","pubkey":"0xa35e34e6aff03a0e37e0aeeeb2629ba3b503b285ddc75ff2ef8dc854653d833af289f0458cd614e3906ec5e9627b31db"%s},"signature":"0xb735529068b64c24c7650b08ddb09d543b79030888801176d2708f0e0c863a965fc1ba03f8fb14e5b3b486386e1f147b13848c218e143b513886a0f210c096bd03077fcac658c39402f2ca9075422a6df6b54f17f4141334239f9f9ff8137be0"}}`
+)
+
+// bodyServer returns a fixed body for all requests.
+func bodyServer(body []byte, encoding string) *httptest.Server {
+	return httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if encoding != "" {
+			w.Header().Set("Content-Encoding", encoding)
+		}
+		_, _ = w.Write(body)
+	}))
+}
+
+func TestStrictDecoding(t *testing.T) {
+	ctx := context.Background()
+
+	valid := fmt.Sprintf(receivedBidTraceJSON, "34682404831419603", "")
+	tests := []struct {
+		name   string
+		body   string
+		strict bool
+		index  int
+		field  string
+		err    string
+	}{
+		{
+			name: "Valid",
+			body: fmt.Sprintf(`[%s,%s]`, valid, valid),
+		},
+		{
+			name:   "ValidStrict",
+			body:   fmt.Sprintf(`[%s,%s]`, valid, valid),
+			strict: true,
+		},
+		{
+			name: "UnknownField",
+			body: fmt.Sprintf(`[%s,%s]`, valid, fmt.Sprintf(receivedBidTraceJSON, "1", `,"extra":"1"`)),
+		},
+		{
+			name:   "UnknownFieldStrict",
+			body:   fmt.Sprintf(`[%s,%s]`, valid, fmt.Sprintf(receivedBidTraceJSON, "1", `,"extra":"1"`)),
+			strict: true,
+			index:  1,
+			field:  "extra",
+			err:    "failed to parse received bid traces: record 1 field extra: unknown field",
+		},
+		{
+			name:   "DuplicateFieldStrict",
+			body:   fmt.Sprintf(`[%s]`, fmt.Sprintf(receivedBidTraceJSON, "1", `,"value":"2"`)),
+			strict: true,
+			field:  "value",
+			err:    "failed to parse received bid traces: record 0 field value: duplicate field",
+		},
+		{
+			name:   "ValueOverflowStrict",
+			body:   fmt.Sprintf(`[%s,%s,%s]`, valid, valid, fmt.Sprintf(receivedBidTraceJSON, "115792089237316195423570985008687907853269984665640564039457584007913129639936", "")),
+			strict: true,
+			index:  2,
+			field:  "value",
+			err:    "failed to parse received bid traces: record 2 field value: value out of range",
+		},
+		{
+			name:   "ValueNegativeStrict",
+			body:   fmt.Sprintf(`[%s]`, fmt.Sprintf(receivedBidTraceJSON, "-1", "")),
+			strict: true,
+			field:  "value",
+			err:    "failed to parse received bid traces: record 0 field value: expected decimal integer",
+		},
+		{
+			name:   "SlotOverflowStrict",
+			body:   fmt.Sprintf(`[%s]`, strings.Replace(valid, `"slot":"1"`, `"slot":"18446744073709551616"`, 1)),
+			strict: true,
+			field:  "slot",
+			err:    "failed to parse received bid traces: record 0 field slot: value out of range",
+		},
+		{
+			name:   "ShortHashStrict",
+			body:   fmt.Sprintf(`[%s]`, strings.Replace(valid, `"block_hash":"0x4c4f`, `"block_hash":"0x`, 1)),
+			strict: true,
+			field:  "block_hash",
+			err:    "failed to parse received bid traces: record 0 field block_hash: expected 32 bytes of 0x-prefixed hex",
+		},
+		{
+			name:   "MissingFieldStrict",
+			body:   fmt.Sprintf(`[%s]`, strings.Replace(valid, `"gas_used":"12077817",`, ``, 1)),
+			strict: true,
+			field:  "gas_used",
+			err:    "failed to parse received bid traces: record 0 field gas_used: missing",
+		},
+		{
+			name:   "NotObjectStrict",
+			body:   fmt.Sprintf(`[%s,null]`, valid),
+			strict: true,
+			index:  1,
+			err:    "failed to parse received bid traces: record 1: expected {",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := bodyServer([]byte(test.body), "")
+			defer server.Close()
+
+			service, err := http.New(ctx,
+				http.WithTimeout(5*time.Second),
+				http.WithAddress(server.URL),
+				http.WithStrictDecoding(test.strict),
+			)
+			require.NoError(t, err)
+
+			traces, err := service.(client.ReceivedBidTracesProvider).ReceivedBidTraces(ctx, 1)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				var decodeErr *http.DecodeError
+				require.True(t, errors.As(err, &decodeErr))
+				require.Equal(t, test.index, decodeErr.Index)
+				require.Equal(t, test.field, decodeErr.Field)
+				return
+			}
+			require.NoError(t, err)
+			require.NotEmpty(t, traces)
+		})
+	}
+}
+
+func TestStrictDecodingNested(t *testing.T) {
+	ctx := context.Background()
+	server := bodyServer([]byte(fmt.Sprintf(`[%s]`, fmt.Sprintf(queuedProposerJSON, `,"extra":"1"`))), "")
+	defer server.Close()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(5*time.Second),
+		http.WithAddress(server.URL),
+		http.WithStrictDecoding(true),
+	)
+	require.NoError(t, err)
+
+	_, err = service.(client.QueuedProposersProvider).QueuedProposers(ctx)
+	require.EqualError(t, err, "failed to parse queued proposers: record 0 field entry.message.extra: unknown field")
+}
+
+func TestMaxResponseSize(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := http.New(ctx,
+		http.WithAddress("http://localhost:18550"),
+		http.WithMaxResponseSize(-1),
+	)
+	require.EqualError(t, err, "problem with parameters: maximum response size cannot be negative")
+
+	large := []byte(fmt.Sprintf(`[%s]`, strings.Repeat(" ", 1024*1024)))
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	_, err = writer.Write(large)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	tests := []struct {
+		name     string
+		body     []byte
+		encoding string
+		limit    int64
+		err      string
+	}{
+		{
+			name: "Unlimited",
+			body: large,
+		},
+		{
+			name:  "WithinLimit",
+			body:  large,
+			limit: int64(len(large)),
+		},
+		{
+			name:  "TooLarge",
+			body:  large,
+			limit: 1024,
+			err:   "failed to request received bid traces: response to /relay/v1/data/bidtraces/builder_blocks_received?slot=1 exceeds maximum size of 1024 bytes",
+		},
+		{
+			name:     "DecodedTooLarge",
+			body:     compressed.Bytes(),
+			encoding: http.EncodingGzip,
+			limit:    int64(compressed.Len()),
+			err:      fmt.Sprintf("failed to request received bid traces: response to /relay/v1/data/bidtraces/builder_blocks_received?slot=1 exceeds maximum size of %d bytes", compressed.Len()),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := bodyServer(test.body, test.encoding)
+			defer server.Close()
+
+			service, err := http.New(ctx,
+				http.WithTimeout(5*time.Second),
+				http.WithAddress(server.URL),
+				http.WithMaxResponseSize(test.limit),
+			)
+			require.NoError(t, err)
+
+			_, err = service.(client.ReceivedBidTracesProvider).ReceivedBidTraces(ctx, 1)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				var tooLargeErr *http.ResponseTooLargeError
+				require.True(t, errors.As(err, &tooLargeErr))
+				require.Equal(t, test.limit, tooLargeErr.Limit)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLenientDecoding(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := http.New(ctx,
+		http.WithAddress("http://localhost:18550"),
+		http.WithStrictDecoding(true),
+		http.WithLenientDecoding(true),
+	)
+	require.EqualError(t, err, "problem with parameters: strict and lenient decoding cannot both be enabled")
+
+	valid := fmt.Sprintf(receivedBidTraceJSON, "34682404831419603", "")
+	emptyValue := fmt.Sprintf(receivedBidTraceJSON, "", "")
+	noTimestamp := strings.Replace(strings.Replace(valid, `,"timestamp":"1663144444"`, ``, 1), `,"timestamp_ms":"1663144444123"`, ``, 1)
+	server := bodyServer([]byte(fmt.Sprintf(`[%s,%s,%s,null,%s]`, valid, emptyValue, noTimestamp, valid)), "")
+	defer server.Close()
+
+	standard, err := http.New(ctx,
+		http.WithTimeout(5*time.Second),
+		http.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+	_, err = standard.(client.ReceivedBidTracesProvider).ReceivedBidTraces(ctx, 1)
+	require.EqualError(t, err, "failed to parse received bid traces: value missing")
+
+	lenient, err := http.New(ctx,
+		http.WithTimeout(5*time.Second),
+		http.WithAddress(server.URL),
+		http.WithLenientDecoding(true),
+	)
+	require.NoError(t, err)
+
+	traces, err := lenient.(client.ReceivedBidTracesProvider).ReceivedBidTraces(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, traces, 2)
+
+	res, err := lenient.(client.ReceivedBidTracesWithErrorsProvider).ReceivedBidTracesWithErrors(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, res.Traces, 2)
+	require.Equal(t, "34682404831419603", res.Traces[1].Value.String())
+	require.Len(t, res.Errors, 3)
+	require.Equal(t, 1, res.Errors[0].Index)
+	require.EqualError(t, res.Errors[0], "record 1: value missing")
+	require.JSONEq(t, emptyValue, string(res.Errors[0].Data))
+	require.Equal(t, 2, res.Errors[1].Index)
+	require.EqualError(t, res.Errors[1], "record 2: timestamp missing")
+	require.JSONEq(t, noTimestamp, string(res.Errors[1].Data))
+	require.Equal(t, 3, res.Errors[2].Index)
+	require.EqualError(t, res.Errors[2], "record 3: record is null")
+}
+
+func TestLenientDecodingMalformed(t *testing.T) {
+	ctx := context.Background()
+	server := bodyServer([]byte(`[{"slot":"1"},{`), "")
+	defer server.Close()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(5*time.Second),
+		http.WithAddress(server.URL),
+		http.WithLenientDecoding(true),
+	)
+	require.NoError(t, err)
+
+	_, err = service.(client.ReceivedBidTracesWithErrorsProvider).ReceivedBidTracesWithErrors(ctx, 1)
+	require.EqualError(t, err, "failed to parse received bid traces: record 1: unexpected EOF")
+}
+
+func TestLenientDecodingDelivered(t *testing.T) {
+	ctx := context.Background()
+
+	valid := fmt.Sprintf(receivedBidTraceJSON, "34682404831419603", "")
+	emptyValue := fmt.Sprintf(receivedBidTraceJSON, "", "")
+	server := bodyServer([]byte(fmt.Sprintf(`[%s,%s]`, emptyValue, valid)), "")
+	defer server.Close()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(5*time.Second),
+		http.WithAddress(server.URL),
+		http.WithLenientDecoding(true),
+	)
+	require.NoError(t, err)
+
+	trace, err := service.(client.DeliveredBidTraceProvider).DeliveredBidTrace(ctx, 1)
+	require.EqualError(t, err, "failed to parse delivered bid trace: record 0: value missing")
+	require.Nil(t, trace)
+
+	res, err := service.(client.DeliveredBidTracesProvider).DeliveredBidTraces(ctx, []phase0.Slot{1})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Nil(t, res[1].Trace)
+	require.EqualError(t, res[1].Err, "failed to parse delivered bid trace: record 0: value missing")
+}
@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -50,10 +49,11 @@ func (s *Service) DeliveredBidTrace(ctx context.Context, slot phase0.Slot) (*v1.
 		return nil, errors.New("failed to obtain delivered bid trace")
 	}
 
-	res := make([]*v1.BidTrace, 0)
+	var res []*v1.BidTrace
 	switch contentType {
 	case ContentTypeJSON:
-		if err := json.NewDecoder(respBodyReader).Decode(&res); err != nil {
+		res, err = decodeAllRecords[*v1.BidTrace](respBodyReader, s.decoding, bidTraceSchema)
+		if err != nil {
 			return nil, errors.Wrap(err, "failed to parse delivered bid trace")
 		}
 	default:
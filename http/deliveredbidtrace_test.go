@@ -16,7 +16,6 @@ package http_test
 import (
 	"context"
 	"fmt"
-	"os"
 	"testing"
 
 	client "github.com/attestantio/go-relay-client"
@@ -35,7 +34,7 @@ func TestDeliveredBidTrace(t *testing.T) {
 
 	service, err := http.New(context.Background(),
 		http.WithTimeout(timeout),
-		http.WithAddress(os.Getenv("HTTP_ADDRESS")),
+		http.WithAddress(relayAddress(t)),
 	)
 	require.NoError(t, err)
 
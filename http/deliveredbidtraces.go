@@ -0,0 +1,160 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-relay-client/api"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// deliveredBidTracesPageSize is the number of bid traces requested by each range query.
+const deliveredBidTracesPageSize = 200
+
+// DeliveredBidTraces provides bid traces of delivered payloads for the given slots.
+// The result contains an entry for each slot, with any error specific to that slot.
+//
+// Slots are obtained with range queries where the relay supports them, and
+// otherwise with concurrent requests for individual slots.
+func (s *Service) DeliveredBidTraces(ctx context.Context,
+	slots []phase0.Slot,
+) (
+	map[phase0.Slot]*api.DeliveredBidTraceResult,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.go-relay-client.http").Start(ctx, "DeliveredBidTraces", trace.WithAttributes(
+		attribute.Int("slots", len(slots)),
+	))
+	defer span.End()
+	started := time.Now()
+
+	res := make(map[phase0.Slot]*api.DeliveredBidTraceResult, len(slots))
+
+	// Unique slots, latest first.
+	seen := make(map[phase0.Slot]bool, len(slots))
+	pending := make([]phase0.Slot, 0, len(slots))
+	for _, slot := range slots {
+		if !seen[slot] {
+			seen[slot] = true
+			pending = append(pending, slot)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i] > pending[j] })
+
+	remaining, err := s.deliveredBidTracesByRange(ctx, pending, res)
+	if err != nil {
+		log.Debug().Err(err).Int("remaining", len(remaining)).Msg("Range query failed; obtaining remaining slots individually")
+	}
+	s.deliveredBidTracesBySlot(ctx, remaining, res)
+
+	monitorOperation(s.Address(), "delivered bid traces", true, time.Since(started))
+	return res, nil
+}
+
+// deliveredBidTracesByRange obtains delivered bid traces for the slots,
+// which must be ordered latest first, using range queries.
+// It returns the slots that it could not obtain.
+func (s *Service) deliveredBidTracesByRange(ctx context.Context,
+	slots []phase0.Slot,
+	res map[phase0.Slot]*api.DeliveredBidTraceResult,
+) (
+	[]phase0.Slot,
+	error,
+) {
+	for len(slots) > 0 {
+		cursor := slots[0]
+		traces, err := s.deliveredBidTracesPage(ctx, cursor)
+		if err != nil {
+			return slots, err
+		}
+
+		// The page covers the slots from the earliest returned up to the
+		// cursor.  A relay could return fewer traces than requested even if
+		// more are available, so an incomplete page is not taken to cover
+		// earlier slots unless it is empty.
+		covered := phase0.Slot(0)
+		bySlot := make(map[phase0.Slot]*v1.BidTrace, len(traces))
+		for i, trace := range traces {
+			if trace == nil {
+				continue
+			}
+			if trace.Slot > cursor {
+				return slots, errors.New("relay does not support cursor")
+			}
+			if _, exists := bySlot[trace.Slot]; !exists {
+				bySlot[trace.Slot] = trace
+			}
+			if i == 0 || trace.Slot < covered {
+				covered = trace.Slot
+			}
+		}
+
+		i := 0
+		for ; i < len(slots) && slots[i] >= covered; i++ {
+			res[slots[i]] = &api.DeliveredBidTraceResult{
+				Trace: bySlot[slots[i]],
+			}
+		}
+		slots = slots[i:]
+	}
+
+	return nil, nil
+}
+
+// deliveredBidTracesPage obtains up to a page of delivered bid traces for
+// slots at or before the cursor.
+func (s *Service) deliveredBidTracesPage(ctx context.Context, cursor phase0.Slot) ([]*v1.BidTrace, error) {
+	return s.queryDeliveredBidTraces(ctx, url.Values{
+		"cursor": []string{fmt.Sprintf("%d", cursor)},
+		"limit":  []string{fmt.Sprintf("%d", deliveredBidTracesPageSize)},
+	})
+}
+
+// deliveredBidTracesBySlot obtains delivered bid traces for the slots with
+// individual requests.
+func (s *Service) deliveredBidTracesBySlot(ctx context.Context,
+	slots []phase0.Slot,
+	res map[phase0.Slot]*api.DeliveredBidTraceResult,
+) {
+	var mu sync.Mutex
+	sem := make(chan struct{}, s.batchConcurrency)
+	var wg sync.WaitGroup
+	for _, slot := range slots {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(slot phase0.Slot) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			trace, err := s.DeliveredBidTrace(ctx, slot)
+			mu.Lock()
+			res[slot] = &api.DeliveredBidTraceResult{
+				Trace: trace,
+				Err:   err,
+			}
+			mu.Unlock()
+		}(slot)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,203 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+// deliveriesServer serves delivered bid traces for every third slot.
+type deliveriesServer struct {
+	// ignoreCursor ignores the cursor, always returning the latest traces.
+	ignoreCursor bool
+	// failSlot is a slot for which individual requests fail.
+	failSlot phase0.Slot
+
+	rangeRequests atomic.Int32
+	slotRequests  atomic.Int32
+}
+
+func (s *deliveriesServer) delivered(slot phase0.Slot) bool {
+	return slot%3 == 0 && slot <= 1000
+}
+
+func (s *deliveriesServer) ServeHTTP(w nethttp.ResponseWriter, r *nethttp.Request) {
+	if r.URL.Path != "/relay/v1/data/bidtraces/proposer_payload_delivered" {
+		nethttp.NotFound(w, r)
+		return
+	}
+	query := r.URL.Query()
+
+	traces := make([]*v1.BidTrace, 0)
+	switch {
+	case query.Has("slot"):
+		s.slotRequests.Add(1)
+		slot, err := strconv.ParseUint(query.Get("slot"), 10, 64)
+		if err != nil || phase0.Slot(slot) == s.failSlot {
+			nethttp.Error(w, "failed", nethttp.StatusInternalServerError)
+			return
+		}
+		if s.delivered(phase0.Slot(slot)) {
+			traces = append(traces, &v1.BidTrace{Slot: phase0.Slot(slot), Value: big.NewInt(int64(slot))})
+		}
+	default:
+		s.rangeRequests.Add(1)
+		cursor := uint64(1000)
+		if query.Has("cursor") && !s.ignoreCursor {
+			cursor, _ = strconv.ParseUint(query.Get("cursor"), 10, 64)
+		}
+		limit, _ := strconv.ParseUint(query.Get("limit"), 10, 64)
+		for slot := phase0.Slot(cursor); slot > 0 && uint64(len(traces)) < limit; slot-- {
+			if s.delivered(slot) {
+				traces = append(traces, &v1.BidTrace{Slot: slot, Value: big.NewInt(int64(slot))})
+			}
+		}
+	}
+
+	data, err := json.Marshal(traces)
+	if err != nil {
+		nethttp.Error(w, err.Error(), nethttp.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+func slotRange(first phase0.Slot, last phase0.Slot) []phase0.Slot {
+	slots := make([]phase0.Slot, 0)
+	for slot := first; slot <= last; slot++ {
+		slots = append(slots, slot)
+	}
+
+	return slots
+}
+
+func TestDeliveredBidTraces(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		handler       *deliveriesServer
+		slots         []phase0.Slot
+		rangeRequests int32
+		slotRequests  int32
+		failed        []phase0.Slot
+	}{
+		{
+			name:          "Empty",
+			handler:       &deliveriesServer{},
+			slots:         []phase0.Slot{},
+			rangeRequests: 0,
+		},
+		{
+			name:          "Range",
+			handler:       &deliveriesServer{},
+			slots:         slotRange(701, 1000),
+			rangeRequests: 1,
+		},
+		{
+			name:    "RangeMultiplePages",
+			handler: &deliveriesServer{},
+			// 200 traces per page cover 600 slots, and the final page
+			// confirms that there are no traces for the earliest slots.
+			slots:         slotRange(1, 1000),
+			rangeRequests: 3,
+		},
+		{
+			name:    "Sparse",
+			handler: &deliveriesServer{},
+			// Duplicates are ignored; slots beyond the data are empty.
+			slots:         []phase0.Slot{2000, 999, 10, 10, 9},
+			rangeRequests: 2,
+		},
+		{
+			name:          "CursorIgnored",
+			handler:       &deliveriesServer{ignoreCursor: true},
+			slots:         []phase0.Slot{1, 2, 3},
+			rangeRequests: 1,
+			slotRequests:  3,
+		},
+		{
+			name:          "CursorIgnoredFailure",
+			handler:       &deliveriesServer{ignoreCursor: true, failSlot: 2},
+			slots:         []phase0.Slot{1, 2, 3},
+			rangeRequests: 1,
+			slotRequests:  3,
+			failed:        []phase0.Slot{2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(test.handler)
+			defer server.Close()
+
+			service, err := http.New(ctx,
+				http.WithTimeout(5*time.Second),
+				http.WithAddress(server.URL),
+				http.WithBatchConcurrency(2),
+			)
+			require.NoError(t, err)
+
+			res, err := service.(client.DeliveredBidTracesProvider).DeliveredBidTraces(ctx, test.slots)
+			require.NoError(t, err)
+			require.Equal(t, test.rangeRequests, test.handler.rangeRequests.Load())
+			require.Equal(t, test.slotRequests, test.handler.slotRequests.Load())
+
+			failed := make([]phase0.Slot, 0)
+			for _, slot := range test.slots {
+				result, exists := res[slot]
+				require.True(t, exists)
+				if result.Err != nil {
+					failed = append(failed, slot)
+					continue
+				}
+				if test.handler.delivered(slot) {
+					require.NotNil(t, result.Trace, slot)
+					require.Equal(t, slot, result.Trace.Slot)
+				} else {
+					require.Nil(t, result.Trace, slot)
+				}
+			}
+			sort.Slice(failed, func(i, j int) bool { return failed[i] < failed[j] })
+			if test.failed == nil {
+				test.failed = []phase0.Slot{}
+			}
+			require.Equal(t, test.failed, failed)
+		})
+	}
+}
+
+func TestBatchConcurrency(t *testing.T) {
+	_, err := http.New(context.Background(),
+		http.WithAddress("http://localhost:18550"),
+		http.WithBatchConcurrency(0),
+	)
+	require.EqualError(t, err, "problem with parameters: batch concurrency must be at least 1")
+}
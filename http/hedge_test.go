@@ -0,0 +1,181 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-relay-client"
+	"github.com/attestantio/go-relay-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+// mirrorServer serves an empty list of queued proposers after a delay, or fails.
+type mirrorServer struct {
+	delay    time.Duration
+	fail     bool
+	requests atomic.Int32
+	canceled atomic.Int32
+}
+
+func (s *mirrorServer) ServeHTTP(w nethttp.ResponseWriter, r *nethttp.Request) {
+	s.requests.Add(1)
+	select {
+	case <-time.After(s.delay):
+	case <-r.Context().Done():
+		s.canceled.Add(1)
+		return
+	}
+	if s.fail {
+		nethttp.Error(w, "unavailable", nethttp.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`[]`))
+}
+
+func TestHedgeParameters(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := http.New(ctx,
+		http.WithAddress("http://localhost:18550"),
+		http.WithBackupAddresses([]string{""}),
+	)
+	require.EqualError(t, err, "problem with parameters: empty backup address specified")
+
+	_, err = http.New(ctx,
+		http.WithAddress("http://localhost:18550"),
+		http.WithHedgeDelay(-time.Second),
+	)
+	require.EqualError(t, err, "problem with parameters: hedge delay cannot be negative")
+
+	_, err = http.New(ctx,
+		http.WithAddress("http://localhost:18550"),
+		http.WithBackupAddresses([]string{"http://0x1@localhost:18551"}),
+	)
+	require.EqualError(t, err, "invalid backup address http://0x1@localhost:18551: failed to parse public key 0x1: encoding/hex: odd length hex string")
+}
+
+func TestHedging(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		primary  *mirrorServer
+		backups  []*mirrorServer
+		delay    time.Duration
+		err      string
+		maxTime  time.Duration
+		requests []int32
+	}{
+		{
+			name:     "PrimaryFast",
+			primary:  &mirrorServer{},
+			backups:  []*mirrorServer{{}},
+			delay:    time.Second,
+			maxTime:  500 * time.Millisecond,
+			requests: []int32{1, 0},
+		},
+		{
+			name:     "PrimarySlow",
+			primary:  &mirrorServer{delay: 5 * time.Second},
+			backups:  []*mirrorServer{{}},
+			delay:    50 * time.Millisecond,
+			maxTime:  time.Second,
+			requests: []int32{1, 1},
+		},
+		{
+			name:    "PrimaryFails",
+			primary: &mirrorServer{fail: true},
+			backups: []*mirrorServer{{fail: true}, {}},
+			// Failures move on to the next backup without waiting.
+			delay:    10 * time.Second,
+			maxTime:  time.Second,
+			requests: []int32{1, 1, 1},
+		},
+		{
+			name:     "AllFail",
+			primary:  &mirrorServer{fail: true},
+			backups:  []*mirrorServer{{fail: true}},
+			delay:    10 * time.Second,
+			err:      "failed to request queued proposers: GET failed with status 503: unavailable\n",
+			maxTime:  time.Second,
+			requests: []int32{1, 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			primary := httptest.NewServer(test.primary)
+			defer primary.Close()
+			backupAddresses := make([]string, 0, len(test.backups))
+			for _, backup := range test.backups {
+				server := httptest.NewServer(backup)
+				defer server.Close()
+				backupAddresses = append(backupAddresses, server.URL)
+			}
+
+			service, err := http.New(ctx,
+				http.WithTimeout(10*time.Second),
+				http.WithAddress(primary.URL),
+				http.WithBackupAddresses(backupAddresses),
+				http.WithHedgeDelay(test.delay),
+			)
+			require.NoError(t, err)
+			require.Equal(t, primary.URL, service.Address())
+
+			started := time.Now()
+			_, err = service.(client.QueuedProposersProvider).QueuedProposers(ctx)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Less(t, time.Since(started), test.maxTime)
+
+			requests := []int32{test.primary.requests.Load()}
+			for _, backup := range test.backups {
+				requests = append(requests, backup.requests.Load())
+			}
+			require.Equal(t, test.requests, requests)
+		})
+	}
+}
+
+func TestHedgingCancels(t *testing.T) {
+	ctx := context.Background()
+
+	slow := &mirrorServer{delay: 5 * time.Second}
+	primary := httptest.NewServer(slow)
+	defer primary.Close()
+	backup := httptest.NewServer(&mirrorServer{})
+	defer backup.Close()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(10*time.Second),
+		http.WithAddress(primary.URL),
+		http.WithBackupAddresses([]string{backup.URL}),
+		http.WithHedgeDelay(0),
+	)
+	require.NoError(t, err)
+
+	_, err = service.(client.QueuedProposersProvider).QueuedProposers(ctx)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return slow.canceled.Load() == 1 }, time.Second, 10*time.Millisecond)
+}
@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel"
@@ -32,15 +33,138 @@ import (
 
 // get sends an HTTP get request and returns the body.
 // If the response from the server is a 404 this will return nil for both the reader and the error.
+//
+// Concurrent requests for the same endpoint are coalesced in to a single
+// call, and responses are cached for the TTL configured for the endpoint.
 func (s *Service) get(ctx context.Context, endpoint string) (ContentType, io.Reader, error) {
+	path, _, _ := strings.Cut(endpoint, "?")
+
+	if response := s.cachedResponse(endpoint); response != nil {
+		monitorCache(s.address, path, "hit")
+		return response.contentType, response.reader(), nil
+	}
+
+	leader := false
+	ch := s.requests.DoChan(endpoint, func() (any, error) {
+		leader = true
+		// The request is shared, so it must not be canceled by any single caller.
+		contentType, data, err := s.doGet(context.WithoutCancel(ctx), endpoint)
+		if err != nil {
+			return nil, err
+		}
+		response := &cachedResponse{
+			contentType: contentType,
+			data:        data,
+		}
+		s.cacheResponse(endpoint, path, response)
+
+		return response, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return ContentTypeUnknown, nil, errors.Wrap(ctx.Err(), "failed to call GET endpoint")
+	case res := <-ch:
+		if leader {
+			monitorCache(s.address, path, "miss")
+		} else {
+			monitorCache(s.address, path, "coalesced")
+		}
+		if res.Err != nil {
+			return ContentTypeUnknown, nil, res.Err
+		}
+		response, isResponse := res.Val.(*cachedResponse)
+		if !isResponse {
+			return ContentTypeUnknown, nil, errors.New("unexpected response type")
+		}
+
+		return response.contentType, response.reader(), nil
+	}
+}
+
+// getResult is the result of a GET request to a single address.
+type getResult struct {
+	contentType ContentType
+	data        []byte
+	err         error
+}
+
+// doGet sends an HTTP get request and returns the body.
+// If the response from the server is a 404 this will return nil for both the data and the error.
+//
+// If backup addresses are configured the request is also sent to the next
+// backup each time the hedge delay passes without a successful response, or
+// immediately if a request fails.  The first successful response is
+// returned and outstanding requests are canceled.
+func (s *Service) doGet(ctx context.Context, endpoint string) (ContentType, []byte, error) {
+	if len(s.backups) == 0 {
+		return s.getFrom(ctx, s.base, endpoint)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	bases := append([]*url.URL{s.base}, s.backups...)
+	results := make(chan *getResult, len(bases))
+	launched := 0
+	launch := func() {
+		base := bases[launched]
+		launched++
+		if launched > 1 {
+			log.Trace().Str("endpoint", endpoint).Str("address", base.String()).Msg("Sending hedged request")
+		}
+		go func() {
+			contentType, data, err := s.getFrom(ctx, base, endpoint)
+			results <- &getResult{
+				contentType: contentType,
+				data:        data,
+				err:         err,
+			}
+		}()
+	}
+
+	launch()
+	timer := time.NewTimer(s.hedgeDelay)
+	defer timer.Stop()
+
+	var err error
+	for received := 0; received < len(bases); {
+		select {
+		case res := <-results:
+			received++
+			if res.err == nil {
+				return res.contentType, res.data, nil
+			}
+			if err == nil {
+				err = res.err
+			}
+			if received == launched && launched < len(bases) {
+				// Nothing outstanding, so try the next address now.
+				launch()
+				timer.Reset(s.hedgeDelay)
+			}
+		case <-timer.C:
+			if launched < len(bases) {
+				launch()
+				timer.Reset(s.hedgeDelay)
+			}
+		}
+	}
+
+	return ContentTypeUnknown, nil, err
+}
+
+// getFrom sends an HTTP get request to the given base address and returns the body.
+// If the response from the server is a 404 this will return nil for both the data and the error.
+func (s *Service) getFrom(ctx context.Context, base *url.URL, endpoint string) (ContentType, []byte, error) {
 	ctx, span := otel.Tracer("attestantio.go-relay-client.http").Start(ctx, "get")
 	defer span.End()
 
 	// #nosec G404
-	log := log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("endpoint", endpoint).Str("address", s.address).Logger()
+	log := log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("endpoint", endpoint).Str("address", base.String()).Logger()
 	log.Trace().Msg("GET request")
 
-	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
+	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(base.String(), "/"), endpoint))
 	if err != nil {
 		return ContentTypeUnknown, nil, errors.Wrap(err, "invalid endpoint")
 	}
@@ -57,6 +181,9 @@ func (s *Service) get(ctx context.Context, endpoint string) (ContentType, io.Rea
 	s.addExtraHeaders(req)
 	// Prefer SSZ if available.
 	req.Header.Set("Accept", "application/octet-stream;q=1,application/json;q=0.9")
+	if s.acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", s.acceptEncoding)
+	}
 	span.AddEvent("Sending request")
 	resp, err := s.client.Do(req)
 	if err != nil {
@@ -83,13 +210,32 @@ func (s *Service) get(ctx context.Context, endpoint string) (ContentType, io.Rea
 		return ContentTypeUnknown, nil, nil
 	}
 
-	data, err := io.ReadAll(resp.Body)
+	data, err := readAll(resp.Body, s.maxResponseSize)
 	if err != nil {
 		cancel()
 		span.SetStatus(codes.Error, "Failed to read response")
+		if errors.Is(err, errLimitExceeded) {
+			return ContentTypeUnknown, nil, &ResponseTooLargeError{Endpoint: endpoint, Limit: s.maxResponseSize}
+		}
 		return ContentTypeUnknown, nil, errors.Wrap(err, "failed to read GET response")
 	}
-	span.AddEvent("Received response", trace.WithAttributes(attribute.Int("size", len(data))))
+	encoding := resp.Header.Get("Content-Encoding")
+	receivedSize := len(data)
+	data, err = decodeContent(encoding, data, s.maxResponseSize)
+	if err != nil {
+		cancel()
+		span.SetStatus(codes.Error, "Failed to decode response")
+		if errors.Is(err, errLimitExceeded) {
+			return ContentTypeUnknown, nil, &ResponseTooLargeError{Endpoint: endpoint, Limit: s.maxResponseSize}
+		}
+		return ContentTypeUnknown, nil, errors.Wrap(err, "failed to decode GET response")
+	}
+	monitorResponseSize(s.address, encoding, receivedSize, len(data))
+	span.AddEvent("Received response", trace.WithAttributes(
+		attribute.String("encoding", encoding),
+		attribute.Int("received_size", receivedSize),
+		attribute.Int("size", len(data)),
+	))
 
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
@@ -97,7 +243,12 @@ func (s *Service) get(ctx context.Context, endpoint string) (ContentType, io.Rea
 		trimmedResponse := bytes.ReplaceAll(bytes.ReplaceAll(data, []byte{0x0a}, []byte{}), []byte{0x0d}, []byte{})
 		log.Debug().Int("status_code", resp.StatusCode).RawJSON("response", trimmedResponse).Msg("GET failed")
 		span.SetStatus(codes.Error, fmt.Sprintf("Status code %d", resp.StatusCode))
-		return ContentTypeUnknown, nil, fmt.Errorf("GET failed with status %d: %s", resp.StatusCode, string(data))
+		return ContentTypeUnknown, nil, &Error{
+			Method:     http.MethodGet,
+			Endpoint:   endpoint,
+			StatusCode: resp.StatusCode,
+			Data:       data,
+		}
 	}
 	cancel()
 
@@ -108,7 +259,7 @@ func (s *Service) get(ctx context.Context, endpoint string) (ContentType, io.Rea
 		contentType = ContentTypeJSON
 	}
 
-	return contentType, bytes.NewReader(data), nil
+	return contentType, data, nil
 }
 
 func contentTypeFromResp(resp *http.Response) (ContentType, error) {
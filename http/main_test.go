@@ -26,7 +26,18 @@ var timeout = 60 * time.Second
 
 func TestMain(m *testing.M) {
 	zerolog.SetGlobalLevel(zerolog.Disabled)
-	if os.Getenv("HTTP_ADDRESS") != "" {
-		os.Exit(m.Run())
+	os.Exit(m.Run())
+}
+
+// relayAddress provides the address of the relay against which to run
+// integration tests, skipping the test if one is not configured.
+func relayAddress(t *testing.T) string {
+	t.Helper()
+
+	address := os.Getenv("HTTP_ADDRESS")
+	if address == "" {
+		t.Skip("HTTP_ADDRESS not set")
 	}
+
+	return address
 }
@@ -23,6 +23,8 @@ import (
 var (
 	operationsCounter *prometheus.CounterVec
 	operationsTimer   *prometheus.HistogramVec
+	cacheCounter      *prometheus.CounterVec
+	responseSizes     *prometheus.HistogramVec
 )
 
 func registerMetrics(monitor metrics.Service) error {
@@ -63,7 +65,26 @@ func registerPrometheusMetrics() error {
 			3.1, 3.2, 3.3, 3.4, 3.5, 3.6, 3.7, 3.8, 3.9, 4.0,
 		},
 	}, []string{"server", "operation"})
-	return prometheus.Register(operationsTimer)
+	if err := prometheus.Register(operationsTimer); err != nil {
+		return err
+	}
+	cacheCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "eth_builder_client",
+		Subsystem: "cache",
+		Name:      "requests_total",
+		Help:      "The number of GET requests by cache result.",
+	}, []string{"server", "endpoint", "result"})
+	if err := prometheus.Register(cacheCounter); err != nil {
+		return err
+	}
+	responseSizes = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "eth_builder_client",
+		Subsystem: "responses",
+		Name:      "size_bytes",
+		Help:      "The size of responses as received and after decoding.",
+		Buckets:   prometheus.ExponentialBuckets(256, 4, 10),
+	}, []string{"server", "encoding", "stage"})
+	return prometheus.Register(responseSizes)
 }
 
 // monitorOperation monitors an operation.
@@ -80,3 +101,29 @@ func monitorOperation(server string, operation string, succeeded bool, duration
 		operationsCounter.WithLabelValues(server, operation, "failed").Add(1)
 	}
 }
+
+// monitorCache monitors the result of a cache lookup, which is one of
+// "hit", "miss" or "coalesced".
+func monitorCache(server string, endpoint string, result string) {
+	if cacheCounter == nil {
+		// Not registered.
+		return
+	}
+
+	cacheCounter.WithLabelValues(server, endpoint, result).Add(1)
+}
+
+// monitorResponseSize monitors the size of a response as received and after
+// decoding its content encoding.
+func monitorResponseSize(server string, encoding string, receivedSize int, decodedSize int) {
+	if responseSizes == nil {
+		// Not registered.
+		return
+	}
+
+	if encoding == "" {
+		encoding = "identity"
+	}
+	responseSizes.WithLabelValues(server, encoding, "received").Observe(float64(receivedSize))
+	responseSizes.WithLabelValues(server, encoding, "decoded").Observe(float64(decodedSize))
+}
@@ -14,20 +14,33 @@
 package http
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/attestantio/go-relay-client/api"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
 
 type parameters struct {
-	logLevel     zerolog.Level
-	monitor      metrics.Service
-	name         string
-	address      string
-	timeout      time.Duration
-	extraHeaders map[string]string
+	logLevel             zerolog.Level
+	monitor              metrics.Service
+	name                 string
+	address              string
+	backupAddresses      []string
+	hedgeDelay           time.Duration
+	streamPingInterval   time.Duration
+	timeout              time.Duration
+	extraHeaders         map[string]string
+	registrationVerifier RegistrationVerifier
+	cacheTTLs            map[string]time.Duration
+	batchConcurrency     int
+	contentEncodings     []string
+	maxResponseSize      int64
+	strictDecoding       bool
+	lenientDecoding      bool
 }
 
 // Parameter is the interface for service parameters.
@@ -69,6 +82,32 @@ func WithAddress(address string) Parameter {
 	})
 }
 
+// WithBackupAddresses provides backup addresses for the endpoint, for
+// example regional mirrors, to which requests are sent if the primary
+// address is slow or fails.  Backups are used in the order supplied.
+func WithBackupAddresses(addresses []string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.backupAddresses = addresses
+	})
+}
+
+// WithHedgeDelay sets the time to wait for a response before also sending a
+// request to the next backup address.
+func WithHedgeDelay(delay time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.hedgeDelay = delay
+	})
+}
+
+// WithStreamPingInterval sets the interval at which streams ping the relay.
+// A stream that receives neither a message nor a pong for two intervals is
+// taken to be disconnected, and reconnects.
+func WithStreamPingInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.streamPingInterval = interval
+	})
+}
+
 // WithTimeout sets the maximum duration for all requests to the endpoint.
 func WithTimeout(timeout time.Duration) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -83,12 +122,91 @@ func WithExtraHeaders(headers map[string]string) Parameter {
 	})
 }
 
+// RegistrationVerifier splits queued proposers into those with valid
+// registrations and those without.  verify.QueuedProposersVerifier provides
+// a verifier that checks registration signatures.
+type RegistrationVerifier func(proposers []*v1.QueuedProposer) ([]*v1.QueuedProposer, []*api.InvalidRegistration)
+
+// WithRegistrationVerification verifies the registrations of queued
+// proposers with the given verifier.
+// Proposers with invalid registrations are logged and not returned by
+// QueuedProposers; QueuedProposersWithInvalid returns them separately.
+func WithRegistrationVerification(verifier RegistrationVerifier) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.registrationVerifier = verifier
+	})
+}
+
+// WithCacheTTLs sets the time for which responses are cached, keyed by
+// endpoint path (without query parameters).  Endpoints without a TTL are
+// not cached, although concurrent identical requests are always coalesced.
+// By default no responses are cached.
+func WithCacheTTLs(ttls map[string]time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.cacheTTLs = ttls
+	})
+}
+
+// WithBatchConcurrency sets the maximum number of concurrent requests made
+// by operations that fetch data for many slots.
+func WithBatchConcurrency(concurrency int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.batchConcurrency = concurrency
+	})
+}
+
+// WithContentEncodings sets the content encodings that the relay may use
+// for responses, in order of preference.  Supported encodings are
+// EncodingGzip, EncodingZstd and EncodingBrotli.  An empty list requests
+// uncompressed responses.
+func WithContentEncodings(encodings []string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.contentEncodings = encodings
+	})
+}
+
+// WithMaxResponseSize sets the maximum size of a response from the relay,
+// both as received and after decoding its content encoding.  Larger responses
+// result in a ResponseTooLargeError.  The limit also applies to messages on
+// the top bid stream.  0 means no limit.
+func WithMaxResponseSize(size int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxResponseSize = size
+	})
+}
+
+// WithStrictDecoding rejects bid traces and queued proposers that contain
+// unknown fields, duplicate keys or out-of-range values.  Failures result in
+// a DecodeError identifying the record and field.
+func WithStrictDecoding(strict bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.strictDecoding = strict
+	})
+}
+
+// WithLenientDecoding skips received bid traces and queued proposers that
+// cannot be decoded, rather than failing the entire request.  Skipped records
+// are logged, and returned as errors by ReceivedBidTracesWithErrors.
+// Delivered bid traces are never skipped, as a missing delivery would be
+// taken to mean that the relay did not deliver a payload; a delivered bid
+// trace that cannot be decoded fails the request.
+func WithLenientDecoding(lenient bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.lenientDecoding = lenient
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel:     zerolog.GlobalLevel(),
-		timeout:      2 * time.Second,
-		extraHeaders: make(map[string]string),
+		logLevel:           zerolog.GlobalLevel(),
+		timeout:            2 * time.Second,
+		extraHeaders:       make(map[string]string),
+		cacheTTLs:          make(map[string]time.Duration),
+		batchConcurrency:   8,
+		hedgeDelay:         250 * time.Millisecond,
+		streamPingInterval: 15 * time.Second,
+		contentEncodings:   []string{EncodingGzip},
 	}
 	for _, p := range params {
 		if params != nil {
@@ -102,6 +220,36 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.timeout == 0 {
 		return nil, errors.New("no timeout specified")
 	}
+	for _, address := range parameters.backupAddresses {
+		if address == "" {
+			return nil, errors.New("empty backup address specified")
+		}
+	}
+	if parameters.hedgeDelay < 0 {
+		return nil, errors.New("hedge delay cannot be negative")
+	}
+	if parameters.streamPingInterval <= 0 {
+		return nil, errors.New("stream ping interval must be positive")
+	}
+	for _, encoding := range parameters.contentEncodings {
+		if !supportedEncoding(encoding) {
+			return nil, fmt.Errorf("unsupported content encoding %s", encoding)
+		}
+	}
+	if parameters.maxResponseSize < 0 {
+		return nil, errors.New("maximum response size cannot be negative")
+	}
+	if parameters.strictDecoding && parameters.lenientDecoding {
+		return nil, errors.New("strict and lenient decoding cannot both be enabled")
+	}
+	if parameters.batchConcurrency < 1 {
+		return nil, errors.New("batch concurrency must be at least 1")
+	}
+	for path, ttl := range parameters.cacheTTLs {
+		if ttl < 0 {
+			return nil, fmt.Errorf("cache TTL for %s cannot be negative", path)
+		}
+	}
 
 	return &parameters, nil
 }
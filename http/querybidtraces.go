@@ -0,0 +1,91 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const deliveredBidTracesEndpoint = "/relay/v1/data/bidtraces/proposer_payload_delivered"
+
+// QueryDeliveredBidTraces provides bid traces of delivered payloads that match the query,
+// which uses the query parameters of the relay data API.
+func (s *Service) QueryDeliveredBidTraces(ctx context.Context, query url.Values) ([]*v1.BidTrace, error) {
+	ctx, span := otel.Tracer("attestantio.go-relay-client.http").Start(ctx, "QueryDeliveredBidTraces", trace.WithAttributes(
+		attribute.String("query", query.Encode()),
+	))
+	defer span.End()
+	started := time.Now()
+
+	res, err := s.queryDeliveredBidTraces(ctx, query)
+	if err != nil {
+		monitorOperation(s.Address(), "query delivered bid traces", false, time.Since(started))
+		return nil, err
+	}
+
+	monitorOperation(s.Address(), "query delivered bid traces", true, time.Since(started))
+	return res, nil
+}
+
+func (s *Service) queryDeliveredBidTraces(ctx context.Context, query url.Values) ([]*v1.BidTrace, error) {
+	endpoint := fmt.Sprintf("%s?%s", deliveredBidTracesEndpoint, query.Encode())
+
+	contentType, respBodyReader, err := s.get(ctx, endpoint)
+	if err != nil {
+		log.Trace().Str("url", endpoint).Err(err).Msg("Request failed")
+		return nil, errors.Wrap(err, "failed to request delivered bid traces")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain delivered bid traces")
+	}
+
+	var res []*v1.BidTrace
+	switch contentType {
+	case ContentTypeJSON:
+		res, err = decodeAllRecords[*v1.BidTrace](respBodyReader, s.decoding, bidTraceSchema)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse delivered bid traces")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported content type %v", contentType)
+	}
+
+	return res, nil
+}
+
+// QueryReceivedBidTraces provides received bid traces that match the query,
+// which uses the query parameters of the relay data API.
+func (s *Service) QueryReceivedBidTraces(ctx context.Context, query url.Values) ([]*v1.BidTraceWithTimestamp, error) {
+	ctx, span := otel.Tracer("attestantio.go-relay-client.http").Start(ctx, "QueryReceivedBidTraces", trace.WithAttributes(
+		attribute.String("query", query.Encode()),
+	))
+	defer span.End()
+
+	res, err := s.queryReceivedBidTraces(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	logSkippedRecords(receivedBidTracesEndpoint, res.Errors)
+
+	return res.Traces, nil
+}
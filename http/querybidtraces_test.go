@@ -0,0 +1,69 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-relay-client"
+	"github.com/attestantio/go-relay-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryBidTraces(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	var requests []string
+	trace := fmt.Sprintf(receivedBidTraceJSON, "34682404831419603", "")
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		mu.Lock()
+		requests = append(requests, r.URL.RequestURI())
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, "[%s]", trace)
+	}))
+	defer server.Close()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(5*time.Second),
+		http.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	delivered, err := service.(client.DeliveredBidTracesQueryProvider).QueryDeliveredBidTraces(ctx, url.Values{
+		"cursor":   []string{"200"},
+		"order_by": []string{"-value"},
+	})
+	require.NoError(t, err)
+	require.Len(t, delivered, 1)
+
+	received, err := service.(client.ReceivedBidTracesQueryProvider).QueryReceivedBidTraces(ctx, url.Values{
+		"block_number": []string{"15000000"},
+	})
+	require.NoError(t, err)
+	require.Len(t, received, 1)
+
+	require.Equal(t, []string{
+		"/relay/v1/data/bidtraces/proposer_payload_delivered?cursor=200&order_by=-value",
+		"/relay/v1/data/bidtraces/builder_blocks_received?block_number=15000000",
+	}, requests)
+}
@@ -15,10 +15,10 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
+	"github.com/attestantio/go-relay-client/api"
 	v1 "github.com/attestantio/go-relay-client/api/v1"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel"
@@ -28,6 +28,33 @@ import (
 func (s *Service) QueuedProposers(ctx context.Context) ([]*v1.QueuedProposer, error) {
 	ctx, span := otel.Tracer("attestantio.go-relay-client.http").Start(ctx, "QueuedProposers")
 	defer span.End()
+
+	res, err := s.queuedProposers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, registration := range res.Invalid {
+		e := log.Warn().Uint64("slot", uint64(registration.Proposer.Slot)).Err(registration.Err)
+		if registration.Proposer.Entry != nil && registration.Proposer.Entry.Message != nil {
+			e = e.Str("pubkey", fmt.Sprintf("%#x", registration.Proposer.Entry.Message.Pubkey))
+		}
+		e.Msg("Queued proposer has invalid registration; ignoring")
+	}
+
+	return res.Proposers, nil
+}
+
+// QueuedProposersWithInvalid provides information on the proposers queued to obtain a blinded block.
+// If registration verification is enabled proposers with invalid registrations are returned
+// separately in the result.
+func (s *Service) QueuedProposersWithInvalid(ctx context.Context) (*api.QueuedProposersResult, error) {
+	ctx, span := otel.Tracer("attestantio.go-relay-client.http").Start(ctx, "QueuedProposersWithInvalid")
+	defer span.End()
+
+	return s.queuedProposers(ctx)
+}
+
+func (s *Service) queuedProposers(ctx context.Context) (*api.QueuedProposersResult, error) {
 	started := time.Now()
 
 	url := "/relay/v1/builder/validators"
@@ -43,16 +70,23 @@ func (s *Service) QueuedProposers(ctx context.Context) ([]*v1.QueuedProposer, er
 		return nil, errors.New("failed to obtain queued proposers")
 	}
 
-	res := make([]*v1.QueuedProposer, 0)
+	res := &api.QueuedProposersResult{}
 	switch contentType {
 	case ContentTypeJSON:
-		if err := json.NewDecoder(respBodyReader).Decode(&res); err != nil {
+		var skipped []*DecodeError
+		res.Proposers, skipped, err = decodeRecords[*v1.QueuedProposer](respBodyReader, s.decoding, queuedProposerSchema)
+		logSkippedRecords(url, skipped)
+		if err != nil {
 			return nil, errors.Wrap(err, "failed to parse queued proposers")
 		}
 	default:
 		return nil, fmt.Errorf("unsupported content type %v", contentType)
 	}
 
+	if s.registrationVerifier != nil {
+		res.Proposers, res.Invalid = s.registrationVerifier(res.Proposers)
+	}
+
 	monitorOperation(s.Address(), "queued proposers", true, time.Since(started))
 	return res, nil
 }
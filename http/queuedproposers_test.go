@@ -15,11 +15,15 @@ package http_test
 
 import (
 	"context"
-	"os"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	client "github.com/attestantio/go-relay-client"
 	"github.com/attestantio/go-relay-client/http"
+	"github.com/attestantio/go-relay-client/verify"
 	"github.com/stretchr/testify/require"
 )
 
@@ -34,7 +38,7 @@ func TestQueuedProposers(t *testing.T) {
 
 	service, err := http.New(context.Background(),
 		http.WithTimeout(timeout),
-		http.WithAddress(os.Getenv("HTTP_ADDRESS")),
+		http.WithAddress(relayAddress(t)),
 	)
 	require.NoError(t, err)
 
@@ -46,3 +50,32 @@ func TestQueuedProposers(t *testing.T) {
 		})
 	}
 }
+
+func TestQueuedProposersWithInvalid(t *testing.T) {
+	ctx := context.Background()
+
+	valid := fmt.Sprintf(queuedProposerJSON, "")
+	invalid := strings.Replace(valid, `"gas_limit":"30000000"`, `"gas_limit":"30000001"`, 1)
+	server := bodyServer([]byte(fmt.Sprintf("[%s,%s]", valid, invalid)), "")
+	defer server.Close()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(5*time.Second),
+		http.WithAddress(server.URL),
+		// Goerli.
+		http.WithRegistrationVerification(verify.QueuedProposersVerifier(phase0.Version{0x00, 0x00, 0x10, 0x20})),
+	)
+	require.NoError(t, err)
+
+	proposers, err := service.(client.QueuedProposersProvider).QueuedProposers(ctx)
+	require.NoError(t, err)
+	require.Len(t, proposers, 1)
+
+	res, err := service.(client.QueuedProposersWithInvalidProvider).QueuedProposersWithInvalid(ctx)
+	require.NoError(t, err)
+	require.Len(t, res.Proposers, 1)
+	require.Equal(t, uint64(30000000), res.Proposers[0].Entry.Message.GasLimit)
+	require.Len(t, res.Invalid, 1)
+	require.Equal(t, uint64(30000001), res.Invalid[0].Proposer.Entry.Message.GasLimit)
+	require.EqualError(t, res.Invalid[0].Err, "signature does not verify")
+}
@@ -15,11 +15,12 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-relay-client/api"
 	v1 "github.com/attestantio/go-relay-client/api/v1"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel"
@@ -27,6 +28,8 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+const receivedBidTracesEndpoint = "/relay/v1/data/bidtraces/builder_blocks_received"
+
 // ReceivedBidTraces provides all bid traces received for a given slot.
 func (s *Service) ReceivedBidTraces(ctx context.Context, slot phase0.Slot) ([]*v1.BidTraceWithTimestamp, error) {
 	ctx, span := otel.Tracer("attestantio.go-relay-client.http").Start(ctx, "ReceivedBidTraces", trace.WithAttributes(
@@ -34,13 +37,37 @@ func (s *Service) ReceivedBidTraces(ctx context.Context, slot phase0.Slot) ([]*v
 		attribute.Int64("slot", int64(slot)),
 	))
 	defer span.End()
+
+	res, err := s.queryReceivedBidTraces(ctx, slotQuery(slot))
+	if err != nil {
+		return nil, err
+	}
+	logSkippedRecords(receivedBidTracesEndpoint, res.Errors)
+
+	return res.Traces, nil
+}
+
+// ReceivedBidTracesWithErrors provides all bid traces received for a given slot.
+// If lenient decoding is enabled records that cannot be decoded are skipped, and
+// returned as errors in the result.
+func (s *Service) ReceivedBidTracesWithErrors(ctx context.Context, slot phase0.Slot) (*api.ReceivedBidTracesResult, error) {
+	ctx, span := otel.Tracer("attestantio.go-relay-client.http").Start(ctx, "ReceivedBidTracesWithErrors", trace.WithAttributes(
+		//nolint:gosec
+		attribute.Int64("slot", int64(slot)),
+	))
+	defer span.End()
+
+	return s.queryReceivedBidTraces(ctx, slotQuery(slot))
+}
+
+func (s *Service) queryReceivedBidTraces(ctx context.Context, query url.Values) (*api.ReceivedBidTracesResult, error) {
 	started := time.Now()
 
-	url := fmt.Sprintf("/relay/v1/data/bidtraces/builder_blocks_received?slot=%d", slot)
+	endpoint := fmt.Sprintf("%s?%s", receivedBidTracesEndpoint, query.Encode())
 
-	contentType, respBodyReader, err := s.get(ctx, url)
+	contentType, respBodyReader, err := s.get(ctx, endpoint)
 	if err != nil {
-		log.Trace().Str("url", url).Err(err).Msg("Request failed")
+		log.Trace().Str("url", endpoint).Err(err).Msg("Request failed")
 		monitorOperation(s.Address(), "received bid traces", false, time.Since(started))
 		return nil, errors.Wrap(err, "failed to request received bid traces")
 	}
@@ -49,10 +76,11 @@ func (s *Service) ReceivedBidTraces(ctx context.Context, slot phase0.Slot) ([]*v
 		return nil, errors.New("failed to obtain received bid traces")
 	}
 
-	res := make([]*v1.BidTraceWithTimestamp, 0)
+	res := &api.ReceivedBidTracesResult{}
 	switch contentType {
 	case ContentTypeJSON:
-		if err := json.NewDecoder(respBodyReader).Decode(&res); err != nil {
+		res.Traces, res.Errors, err = decodeRecords[*v1.BidTraceWithTimestamp](respBodyReader, s.decoding, bidTraceWithTimestampSchema)
+		if err != nil {
 			monitorOperation(s.Address(), "received bid traces", false, time.Since(started))
 			return nil, errors.Wrap(err, "failed to parse received bid traces")
 		}
@@ -63,3 +91,10 @@ func (s *Service) ReceivedBidTraces(ctx context.Context, slot phase0.Slot) ([]*v
 	monitorOperation(s.Address(), "received bid traces", true, time.Since(started))
 	return res, nil
 }
+
+// slotQuery returns the query for a single slot.
+func slotQuery(slot phase0.Slot) url.Values {
+	return url.Values{
+		"slot": []string{fmt.Sprintf("%d", slot)},
+	}
+}
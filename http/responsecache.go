@@ -0,0 +1,77 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"io"
+	"time"
+)
+
+// cachedResponse is a response held in the in-memory cache.
+type cachedResponse struct {
+	contentType ContentType
+	// data is nil if the endpoint returned no data.
+	data    []byte
+	expires time.Time
+}
+
+// reader returns a new reader for the response data, or nil if there is no data.
+func (r *cachedResponse) reader() io.Reader {
+	if r.data == nil {
+		return nil
+	}
+
+	return bytes.NewReader(r.data)
+}
+
+// cachedResponse returns the cached response for the endpoint, or nil if
+// there is no unexpired response.
+func (s *Service) cachedResponse(endpoint string) *cachedResponse {
+	s.responsesMu.Lock()
+	defer s.responsesMu.Unlock()
+
+	response, exists := s.responses[endpoint]
+	if !exists {
+		return nil
+	}
+	if !time.Now().Before(response.expires) {
+		delete(s.responses, endpoint)
+		return nil
+	}
+
+	return response
+}
+
+// cacheResponse caches the response for the endpoint if the endpoint's path has a TTL.
+func (s *Service) cacheResponse(endpoint string, path string, response *cachedResponse) {
+	ttl := s.cacheTTLs[path]
+	if ttl <= 0 {
+		return
+	}
+
+	s.responsesMu.Lock()
+	defer s.responsesMu.Unlock()
+
+	// Remove expired responses, so that per-slot endpoints do not grow the cache without bound.
+	now := time.Now()
+	for key, cached := range s.responses {
+		if !now.Before(cached.expires) {
+			delete(s.responses, key)
+		}
+	}
+
+	response.expires = now.Add(ttl)
+	s.responses[endpoint] = response
+}
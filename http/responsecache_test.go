@@ -0,0 +1,147 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-relay-client"
+	"github.com/attestantio/go-relay-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+// countingServer counts requests, delaying each response.
+type countingServer struct {
+	delay    time.Duration
+	requests atomic.Int32
+}
+
+func (s *countingServer) ServeHTTP(w nethttp.ResponseWriter, r *nethttp.Request) {
+	s.requests.Add(1)
+	time.Sleep(s.delay)
+	switch r.URL.Path {
+	case "/relay/v1/builder/validators":
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	case "/relay/v1/data/bidtraces/proposer_payload_delivered":
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	default:
+		nethttp.NotFound(w, r)
+	}
+}
+
+func TestCacheTTLs(t *testing.T) {
+	_, err := http.New(context.Background(),
+		http.WithAddress("http://localhost:18550"),
+		http.WithCacheTTLs(map[string]time.Duration{
+			"/relay/v1/builder/validators": -time.Second,
+		}),
+	)
+	require.EqualError(t, err, "problem with parameters: cache TTL for /relay/v1/builder/validators cannot be negative")
+}
+
+func TestCoalescing(t *testing.T) {
+	ctx := context.Background()
+	handler := &countingServer{delay: 200 * time.Millisecond}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(5*time.Second),
+		http.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+	provider := service.(client.QueuedProposersProvider)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			proposers, err := provider.QueuedProposers(ctx)
+			require.NoError(t, err)
+			require.Empty(t, proposers)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(1), handler.requests.Load())
+
+	// By default, sequential requests are not cached.
+	_, err = provider.QueuedProposers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), handler.requests.Load())
+
+	// A caller giving up does not fail the shared request.
+	shortCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	var shortErr error
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, shortErr = provider.QueuedProposers(shortCtx)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	_, err = provider.QueuedProposers(ctx)
+	require.NoError(t, err)
+	wg.Wait()
+	require.ErrorIs(t, shortErr, context.DeadlineExceeded)
+	require.Equal(t, int32(3), handler.requests.Load())
+}
+
+func TestResponseCache(t *testing.T) {
+	ctx := context.Background()
+	handler := &countingServer{}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(5*time.Second),
+		http.WithAddress(server.URL),
+		http.WithCacheTTLs(map[string]time.Duration{
+			"/relay/v1/builder/validators":                        100 * time.Millisecond,
+			"/relay/v1/data/bidtraces/proposer_payload_delivered": time.Minute,
+		}),
+	)
+	require.NoError(t, err)
+
+	proposersProvider := service.(client.QueuedProposersProvider)
+	for range 3 {
+		_, err := proposersProvider.QueuedProposers(ctx)
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(1), handler.requests.Load())
+
+	// Expired responses are refetched.
+	time.Sleep(150 * time.Millisecond)
+	_, err = proposersProvider.QueuedProposers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), handler.requests.Load())
+
+	// Responses are cached by full endpoint, including query.
+	deliveredProvider := service.(client.DeliveredBidTraceProvider)
+	for range 2 {
+		_, err := deliveredProvider.DeliveredBidTrace(ctx, 1)
+		require.NoError(t, err)
+		_, err = deliveredProvider.DeliveredBidTrace(ctx, 2)
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(4), handler.requests.Load())
+}
@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	builderclient "github.com/attestantio/go-builder-client"
@@ -28,17 +29,31 @@ import (
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
+	"golang.org/x/sync/singleflight"
 )
 
 // Service is an Ethereum 2 client service.
 type Service struct {
-	base         *url.URL
-	name         string
-	address      string
-	client       *http.Client
-	timeout      time.Duration
-	pubkey       *phase0.BLSPubKey
-	extraHeaders map[string]string
+	base                 *url.URL
+	backups              []*url.URL
+	hedgeDelay           time.Duration
+	streamPingInterval   time.Duration
+	name                 string
+	address              string
+	client               *http.Client
+	timeout              time.Duration
+	pubkey               *phase0.BLSPubKey
+	extraHeaders         map[string]string
+	registrationVerifier RegistrationVerifier
+	cacheTTLs            map[string]time.Duration
+	batchConcurrency     int
+	acceptEncoding       string
+	maxResponseSize      int64
+	decoding             decodingMode
+
+	requests    singleflight.Group
+	responsesMu sync.Mutex
+	responses   map[string]*cachedResponse
 }
 
 // log is a service-wide logger.
@@ -75,31 +90,23 @@ func New(ctx context.Context, params ...Parameter) (builderclient.Service, error
 			MaxConnsPerHost:     64,
 			MaxIdleConnsPerHost: 64,
 			IdleConnTimeout:     600 * time.Second,
+			// Content encoding is negotiated and decoded explicitly.
+			DisableCompression: true,
 		},
 	}
 
-	address := parameters.address
-	if !strings.HasPrefix(address, "http") {
-		address = fmt.Sprintf("http://%s", parameters.address)
-	}
-	base, err := url.Parse(address)
+	base, pubkey, err := parseAddress(parameters.address)
 	if err != nil {
-		return nil, errors.Wrap(err, "invalid URL")
+		return nil, err
 	}
 
-	// Obtain the public key from the URL's user.
-	var pubkey *phase0.BLSPubKey
-	if base.User != nil && base.User.Username() != "" {
-		key := phase0.BLSPubKey{}
-		data, err := hex.DecodeString(strings.TrimPrefix(base.User.Username(), "0x"))
+	backups := make([]*url.URL, 0, len(parameters.backupAddresses))
+	for _, address := range parameters.backupAddresses {
+		backup, _, err := parseAddress(address)
 		if err != nil {
-			return nil, errors.Wrap(err, fmt.Sprintf("failed to parse public key %s", base.User.Username()))
+			return nil, errors.Wrap(err, fmt.Sprintf("invalid backup address %s", address))
 		}
-		copy(key[:], data)
-		pubkey = &key
-
-		// Remove the user from the URL.
-		base.User = nil
+		backups = append(backups, backup)
 	}
 
 	name := parameters.name
@@ -107,14 +114,32 @@ func New(ctx context.Context, params ...Parameter) (builderclient.Service, error
 		name = base.String()
 	}
 
+	decoding := decodingStandard
+	switch {
+	case parameters.strictDecoding:
+		decoding = decodingStrict
+	case parameters.lenientDecoding:
+		decoding = decodingLenient
+	}
+
 	s := &Service{
-		base:         base,
-		name:         name,
-		address:      base.String(),
-		client:       client,
-		timeout:      parameters.timeout,
-		pubkey:       pubkey,
-		extraHeaders: parameters.extraHeaders,
+		base:                 base,
+		backups:              backups,
+		hedgeDelay:           parameters.hedgeDelay,
+		streamPingInterval:   parameters.streamPingInterval,
+		name:                 name,
+		address:              base.String(),
+		client:               client,
+		timeout:              parameters.timeout,
+		pubkey:               pubkey,
+		extraHeaders:         parameters.extraHeaders,
+		registrationVerifier: parameters.registrationVerifier,
+		cacheTTLs:            parameters.cacheTTLs,
+		batchConcurrency:     parameters.batchConcurrency,
+		acceptEncoding:       strings.Join(parameters.contentEncodings, ", "),
+		maxResponseSize:      parameters.maxResponseSize,
+		decoding:             decoding,
+		responses:            make(map[string]*cachedResponse),
 	}
 
 	// Close the service on context done.
@@ -127,6 +152,35 @@ func New(ctx context.Context, params ...Parameter) (builderclient.Service, error
 	return s, nil
 }
 
+// parseAddress parses a relay address, returning the base URL and the
+// public key of the relay if the address contains it.
+func parseAddress(address string) (*url.URL, *phase0.BLSPubKey, error) {
+	if !strings.HasPrefix(address, "http") {
+		address = fmt.Sprintf("http://%s", address)
+	}
+	base, err := url.Parse(address)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid URL")
+	}
+
+	// Obtain the public key from the URL's user.
+	var pubkey *phase0.BLSPubKey
+	if base.User != nil && base.User.Username() != "" {
+		key := phase0.BLSPubKey{}
+		data, err := hex.DecodeString(strings.TrimPrefix(base.User.Username(), "0x"))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, fmt.Sprintf("failed to parse public key %s", base.User.Username()))
+		}
+		copy(key[:], data)
+		pubkey = &key
+
+		// Remove the user from the URL.
+		base.User = nil
+	}
+
+	return base, pubkey, nil
+}
+
 // Name provides the name of the service.
 func (s *Service) Name() string {
 	return s.name
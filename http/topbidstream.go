@@ -0,0 +1,217 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	topBidEndpoint = "/ws/v1/top_bid"
+	// minReconnectDelay is the initial delay before reconnecting to the stream.
+	minReconnectDelay = 250 * time.Millisecond
+	// maxReconnectDelay is the maximum delay before reconnecting to the stream.
+	maxReconnectDelay = 30 * time.Second
+)
+
+// TopBidStream streams updates to the relay's top bid.
+// The channel is closed when the context is done.
+//
+// The initial connection is made before returning, so an error is returned
+// if the relay does not provide the stream.  Subsequent disconnections,
+// including connections that stop responding to pings, are retried with
+// exponential backoff.
+func (s *Service) TopBidStream(ctx context.Context) (<-chan *v1.TopBid, error) {
+	ctx, span := otel.Tracer("attestantio.go-relay-client.http").Start(ctx, "TopBidStream")
+	defer span.End()
+
+	conn, err := s.dialTopBidStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(chan *v1.TopBid, 64)
+	go s.streamTopBids(ctx, conn, updates)
+
+	return updates, nil
+}
+
+// dialTopBidStream connects to the relay's top bid stream.
+func (s *Service) dialTopBidStream(ctx context.Context) (*websocket.Conn, error) {
+	started := time.Now()
+
+	url := fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), topBidEndpoint)
+	switch {
+	case strings.HasPrefix(url, "https://"):
+		url = "wss://" + strings.TrimPrefix(url, "https://")
+	case strings.HasPrefix(url, "http://"):
+		url = "ws://" + strings.TrimPrefix(url, "http://")
+	}
+
+	header := make(http.Header)
+	for k, v := range s.extraHeaders {
+		header.Add(k, v)
+	}
+
+	dialer := websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: s.timeout,
+	}
+	conn, resp, err := dialer.DialContext(ctx, url, header)
+	if err != nil {
+		monitorOperation(s.Address(), "top bid stream", false, time.Since(started))
+		if resp != nil && resp.StatusCode != http.StatusSwitchingProtocols {
+			// The relay rejected the upgrade, so return its response.
+			defer resp.Body.Close()
+			data, _ := io.ReadAll(resp.Body)
+			return nil, errors.Wrap(&Error{
+				Method:     http.MethodGet,
+				Endpoint:   topBidEndpoint,
+				StatusCode: resp.StatusCode,
+				Data:       data,
+			}, "failed to connect to top bid stream")
+		}
+		return nil, errors.Wrap(err, "failed to connect to top bid stream")
+	}
+	if s.maxResponseSize > 0 {
+		conn.SetReadLimit(s.maxResponseSize)
+	}
+	monitorOperation(s.Address(), "top bid stream", true, time.Since(started))
+
+	return conn, nil
+}
+
+// streamTopBids reads updates from the connection, reconnecting as required.
+func (s *Service) streamTopBids(ctx context.Context,
+	conn *websocket.Conn,
+	updates chan<- *v1.TopBid,
+) {
+	defer close(updates)
+	log := log.With().Str("address", s.address).Str("endpoint", topBidEndpoint).Logger()
+
+	delay := minReconnectDelay
+	for {
+		received, err := s.readTopBids(ctx, conn, updates)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Debug().Err(err).Msg("Top bid stream disconnected")
+		if received {
+			// The connection was good, so start backing off afresh.
+			delay = minReconnectDelay
+		}
+
+		for {
+			log.Trace().Dur("delay", delay).Msg("Waiting to reconnect")
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+			delay *= 2
+			if delay > maxReconnectDelay {
+				delay = maxReconnectDelay
+			}
+
+			conn, err = s.dialTopBidStream(ctx)
+			if err == nil {
+				break
+			}
+			log.Debug().Err(err).Msg("Failed to reconnect to top bid stream")
+		}
+	}
+}
+
+// readTopBids reads updates from the connection until it fails.
+// It returns true if at least one update was received.
+func (s *Service) readTopBids(ctx context.Context,
+	conn *websocket.Conn,
+	updates chan<- *v1.TopBid,
+) (
+	bool,
+	error,
+) {
+	// A connection can fail without being closed, so ping the relay and
+	// treat the connection as failed if nothing is heard back in time.
+	readTimeout := 2 * s.streamPingInterval
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(readTimeout))
+	})
+
+	// Reads block, so close the connection to release them when done.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer conn.Close()
+		ticker := time.NewTicker(s.streamPingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(s.streamPingInterval)); err != nil {
+					log.Trace().Err(err).Str("address", s.address).Msg("Failed to ping top bid stream")
+				}
+			}
+		}
+	}()
+
+	received := false
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(readTimeout)); err != nil {
+			return received, err
+		}
+		messageType, data, err := conn.ReadMessage()
+		if err != nil {
+			return received, err
+		}
+
+		update := &v1.TopBid{}
+		switch messageType {
+		case websocket.TextMessage:
+			err = json.Unmarshal(data, update)
+		case websocket.BinaryMessage:
+			err = update.UnmarshalSSZ(data)
+		default:
+			continue
+		}
+		if err != nil {
+			log.Debug().Err(err).Str("address", s.address).Msg("Failed to parse top bid; ignoring")
+			continue
+		}
+		received = true
+
+		select {
+		case updates <- update:
+		case <-ctx.Done():
+			return received, ctx.Err()
+		}
+	}
+}
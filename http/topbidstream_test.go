@@ -0,0 +1,206 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"math/big"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/http"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// topBidServer serves each connection a message then, for all but the
+// last connection, disconnects.
+type topBidServer struct {
+	upgrader websocket.Upgrader
+	messages []func(*websocket.Conn) error
+
+	mu          sync.Mutex
+	connections int
+}
+
+func (s *topBidServer) ServeHTTP(w nethttp.ResponseWriter, r *nethttp.Request) {
+	if r.URL.Path != "/ws/v1/top_bid" {
+		nethttp.NotFound(w, r)
+		return
+	}
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	s.mu.Lock()
+	connection := s.connections
+	s.connections++
+	s.mu.Unlock()
+	if connection >= len(s.messages) {
+		return
+	}
+	if err := s.messages[connection](conn); err != nil {
+		return
+	}
+	if connection == len(s.messages)-1 {
+		// Hold the final connection open until the client goes away.
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func TestTopBidStreamNotFound(t *testing.T) {
+	server := httptest.NewServer(nethttp.NotFoundHandler())
+	defer server.Close()
+
+	service, err := http.New(context.Background(),
+		http.WithTimeout(time.Second),
+		http.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = service.(client.TopBidStreamProvider).TopBidStream(context.Background())
+	require.EqualError(t, err, "failed to connect to top bid stream: GET failed with status 404: 404 page not found\n")
+}
+
+func TestTopBidStream(t *testing.T) {
+	bid := &v1.TopBid{
+		Timestamp:   time.UnixMilli(1700000000123),
+		Slot:        7654321,
+		BlockNumber: 18500000,
+		BlockHash:   phase0.Hash32{0x01},
+		ParentHash:  phase0.Hash32{0x02},
+		Value:       big.NewInt(123456789),
+	}
+	ssz, err := bid.MarshalSSZ()
+	require.NoError(t, err)
+
+	server := httptest.NewServer(&topBidServer{
+		messages: []func(*websocket.Conn) error{
+			func(conn *websocket.Conn) error {
+				return conn.WriteMessage(websocket.TextMessage, []byte(`{"timestamp":1700000000123,"slot":7654321,"block_number":18500000,"block_hash":"0x0100000000000000000000000000000000000000000000000000000000000000","parent_hash":"0x0200000000000000000000000000000000000000000000000000000000000000","builder_pubkey":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","fee_recipient":"0x0000000000000000000000000000000000000000","value":"123456789"}`))
+			},
+			func(conn *websocket.Conn) error {
+				// Malformed messages should be skipped.
+				if err := conn.WriteMessage(websocket.BinaryMessage, []byte{0x01}); err != nil {
+					return err
+				}
+				return conn.WriteMessage(websocket.BinaryMessage, ssz)
+			},
+		},
+	})
+	defer server.Close()
+
+	service, err := http.New(context.Background(),
+		http.WithTimeout(time.Second),
+		http.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates, err := service.(client.TopBidStreamProvider).TopBidStream(ctx)
+	require.NoError(t, err)
+
+	// The first update arrives as JSON, the second as SSZ after a reconnect.
+	for i := range 2 {
+		select {
+		case update := <-updates:
+			require.Equal(t, bid.String(), update.String(), "update %d", i)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for update")
+		}
+	}
+
+	cancel()
+	select {
+	case _, ok := <-updates:
+		require.False(t, ok)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for stream to close")
+	}
+}
+
+func TestTopBidStreamPingInterval(t *testing.T) {
+	_, err := http.New(context.Background(),
+		http.WithTimeout(time.Second),
+		http.WithAddress("http://localhost:18550"),
+		http.WithStreamPingInterval(0),
+	)
+	require.EqualError(t, err, "problem with parameters: stream ping interval must be positive")
+}
+
+func TestTopBidStreamUnresponsive(t *testing.T) {
+	message := []byte(`{"timestamp":1700000000123,"slot":7654321,"block_number":18500000,"block_hash":"0x0100000000000000000000000000000000000000000000000000000000000000","parent_hash":"0x0200000000000000000000000000000000000000000000000000000000000000","builder_pubkey":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","fee_recipient":"0x0000000000000000000000000000000000000000","value":"123456789"}`)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	handler := &topBidServer{
+		messages: []func(*websocket.Conn) error{
+			func(conn *websocket.Conn) error {
+				if err := conn.WriteMessage(websocket.TextMessage, message); err != nil {
+					return err
+				}
+				// Stop responding without closing the connection.
+				<-stop
+				return nil
+			},
+			func(conn *websocket.Conn) error {
+				return conn.WriteMessage(websocket.TextMessage, message)
+			},
+		},
+	}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	service, err := http.New(context.Background(),
+		http.WithTimeout(time.Second),
+		http.WithAddress(server.URL),
+		http.WithStreamPingInterval(100*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates, err := service.(client.TopBidStreamProvider).TopBidStream(ctx)
+	require.NoError(t, err)
+
+	// The second update arrives after the unresponsive connection times out.
+	for i := range 2 {
+		select {
+		case update := <-updates:
+			require.Equal(t, phase0.Slot(7654321), update.Slot, "update %d", i)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for update")
+		}
+	}
+
+	// The responsive connection answers pings, so is kept open.
+	time.Sleep(500 * time.Millisecond)
+	handler.mu.Lock()
+	connections := handler.connections
+	handler.mu.Unlock()
+	require.Equal(t, 2, connections)
+}
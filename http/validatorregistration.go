@@ -0,0 +1,96 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	builderv1 "github.com/attestantio/go-builder-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ValidatorRegistration provides the latest registration held by the relay for a given validator.
+// Will return nil if the relay does not hold a registration for the validator.
+func (s *Service) ValidatorRegistration(ctx context.Context,
+	pubkey phase0.BLSPubKey,
+) (
+	*builderv1.SignedValidatorRegistration,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.go-relay-client.http").Start(ctx, "ValidatorRegistration", trace.WithAttributes(
+		attribute.String("pubkey", fmt.Sprintf("%#x", pubkey)),
+	))
+	defer span.End()
+	started := time.Now()
+
+	url := fmt.Sprintf("/relay/v1/data/validator_registration?pubkey=%#x", pubkey)
+
+	contentType, respBodyReader, err := s.get(ctx, url)
+	if err != nil {
+		var relayErr *Error
+		if errors.As(err, &relayErr) && notRegistered(relayErr) {
+			monitorOperation(s.Address(), "validator registration", true, time.Since(started))
+			return nil, nil
+		}
+		log.Trace().Str("url", url).Err(err).Msg("Request failed")
+		monitorOperation(s.Address(), "validator registration", false, time.Since(started))
+		return nil, errors.Wrap(err, "failed to request validator registration")
+	}
+	if respBodyReader == nil {
+		// This means there was no registration, but that's an acceptable response.
+		monitorOperation(s.Address(), "validator registration", true, time.Since(started))
+		return nil, nil
+	}
+
+	var res builderv1.SignedValidatorRegistration
+	switch contentType {
+	case ContentTypeJSON:
+		if err := json.NewDecoder(respBodyReader).Decode(&res); err != nil {
+			monitorOperation(s.Address(), "validator registration", false, time.Since(started))
+			return nil, errors.Wrap(err, "failed to parse validator registration")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported content type %v", contentType)
+	}
+
+	monitorOperation(s.Address(), "validator registration", true, time.Since(started))
+	return &res, nil
+}
+
+// notRegistered returns true if the relay's error response states that it
+// does not hold a registration for the validator.  Relays respond with a bad
+// request in this situation, but also for other failures such as a malformed
+// request, so the message is checked as well.
+func notRegistered(relayErr *Error) bool {
+	if relayErr.StatusCode != http.StatusBadRequest {
+		return false
+	}
+	var resp struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(relayErr.Data, &resp); err != nil {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(resp.Message), "no registration found")
+}
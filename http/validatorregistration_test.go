@@ -0,0 +1,130 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	"github.com/attestantio/go-relay-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorRegistration(t *testing.T) {
+	service, err := http.New(context.Background(),
+		http.WithTimeout(timeout),
+		http.WithAddress(relayAddress(t)),
+	)
+	require.NoError(t, err)
+
+	proposers, err := service.(client.QueuedProposersProvider).QueuedProposers(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, proposers)
+
+	tests := []struct {
+		name       string
+		pubkey     phase0.BLSPubKey
+		registered bool
+	}{
+		{
+			name: "Unknown",
+		},
+		{
+			name:       "Good",
+			pubkey:     proposers[0].Entry.Message.Pubkey,
+			registered: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			registration, err := service.(client.ValidatorRegistrationProvider).ValidatorRegistration(context.Background(), test.pubkey)
+			require.NoError(t, err)
+			if test.registered {
+				require.NotNil(t, registration)
+				require.Equal(t, test.pubkey, registration.Message.Pubkey)
+			} else {
+				require.Nil(t, registration)
+			}
+		})
+	}
+}
+
+func TestValidatorRegistrationErrors(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		err    string
+	}{
+		{
+			name:   "NotRegistered",
+			status: nethttp.StatusBadRequest,
+			body:   `{"code":400,"message":"no registration found for validator 0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}`,
+		},
+		{
+			name:   "InvalidPubkey",
+			status: nethttp.StatusBadRequest,
+			body:   `{"code":400,"message":"invalid pubkey"}`,
+			err:    `failed to request validator registration: GET failed with status 400: {"code":400,"message":"invalid pubkey"}`,
+		},
+		{
+			name:   "NotJSON",
+			status: nethttp.StatusBadRequest,
+			body:   `Bad Request`,
+			err:    `failed to request validator registration: GET failed with status 400: Bad Request`,
+		},
+		{
+			name:   "ServerError",
+			status: nethttp.StatusInternalServerError,
+			body:   `{"code":500,"message":"no registration found"}`,
+			err:    `failed to request validator registration: GET failed with status 500: {"code":500,"message":"no registration found"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			service, err := http.New(ctx,
+				http.WithTimeout(5*time.Second),
+				http.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			registration, err := service.(client.ValidatorRegistrationProvider).ValidatorRegistration(ctx, phase0.BLSPubKey{})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				var relayErr *http.Error
+				require.ErrorAs(t, err, &relayErr)
+				require.Equal(t, test.status, relayErr.StatusCode)
+				return
+			}
+			require.NoError(t, err)
+			require.Nil(t, registration)
+		})
+	}
+}
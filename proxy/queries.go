@@ -0,0 +1,391 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"slices"
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/server"
+)
+
+// QueuedProposers provides the proposers queued for the current and next epoch.
+func (s *Service) QueuedProposers(ctx context.Context) ([]*v1.QueuedProposer, error) {
+	sourced, err := s.SourcedQueuedProposers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return records(sourced), nil
+}
+
+// DeliveredBidTraces provides the bid traces of delivered payloads that match the filter.
+func (s *Service) DeliveredBidTraces(ctx context.Context, filter *server.DeliveredBidTracesFilter) ([]*v1.BidTrace, error) {
+	sourced, err := s.SourcedDeliveredBidTraces(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return records(sourced), nil
+}
+
+// ReceivedBidTraces provides the received bid traces that match the filter.
+func (s *Service) ReceivedBidTraces(ctx context.Context, filter *server.ReceivedBidTracesFilter) ([]*v1.BidTraceWithTimestamp, error) {
+	sourced, err := s.SourcedReceivedBidTraces(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return records(sourced), nil
+}
+
+// proposerKey identifies a queued proposer.
+type proposerKey struct {
+	slot   phase0.Slot
+	pubkey phase0.BLSPubKey
+}
+
+// SourcedQueuedProposers provides the proposers queued for the current and
+// next epoch.  Where relays hold different registrations for the same
+// proposer the most recent is returned.
+func (s *Service) SourcedQueuedProposers(ctx context.Context) ([]*server.Sourced[*v1.QueuedProposer], error) {
+	results, err := fanOut(ctx, s.relays, "queued proposers",
+		func(ctx context.Context, relay client.QueuedProposersProvider) ([]*v1.QueuedProposer, error) {
+			return relay.QueuedProposers(ctx)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := make(map[proposerKey]*server.Sourced[*v1.QueuedProposer])
+	for _, result := range results {
+		for _, proposer := range result.res {
+			if proposer == nil || proposer.Entry == nil || proposer.Entry.Message == nil {
+				continue
+			}
+			key := proposerKey{
+				slot:   proposer.Slot,
+				pubkey: proposer.Entry.Message.Pubkey,
+			}
+			existing, exists := merged[key]
+			if !exists {
+				merged[key] = &server.Sourced[*v1.QueuedProposer]{
+					Record: proposer,
+					Relays: []string{result.relay},
+				}
+				continue
+			}
+			if proposer.Entry.Message.Timestamp.After(existing.Record.Entry.Message.Timestamp) {
+				existing.Record = proposer
+			}
+			existing.Relays = append(existing.Relays, result.relay)
+		}
+	}
+
+	res := make([]*server.Sourced[*v1.QueuedProposer], 0, len(merged))
+	for _, proposer := range merged {
+		res = append(res, proposer)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Record.Slot != res[j].Record.Slot {
+			return res[i].Record.Slot < res[j].Record.Slot
+		}
+
+		return bytes.Compare(res[i].Record.Entry.Message.Pubkey[:], res[j].Record.Entry.Message.Pubkey[:]) < 0
+	})
+
+	return res, nil
+}
+
+// SourcedDeliveredBidTraces provides the bid traces of delivered payloads
+// that match the filter.  The query is forwarded to each relay that supports
+// data API queries; relays that can only be queried by slot are used when the
+// filter is for a slot without a block number.  Results are merged, ordered
+// and limited as requested.
+func (s *Service) SourcedDeliveredBidTraces(ctx context.Context,
+	filter *server.DeliveredBidTracesFilter,
+) (
+	[]*server.Sourced[*v1.BidTrace],
+	error,
+) {
+	bySlot := filter.Slot != nil && filter.BlockNumber == nil
+	relays, err := capableRelays[client.DeliveredBidTracesQueryProvider, client.DeliveredBidTraceProvider](s.relays, bySlot, "delivered bid traces")
+	if err != nil {
+		return nil, err
+	}
+
+	query := deliveredBidTracesQuery(filter)
+	results, err := fanOut(ctx, relays, "delivered bid traces",
+		func(ctx context.Context, relay client.Service) ([]*v1.BidTrace, error) {
+			if provider, isProvider := relay.(client.DeliveredBidTracesQueryProvider); isProvider {
+				return provider.QueryDeliveredBidTraces(ctx, query)
+			}
+			trace, err := relay.(client.DeliveredBidTraceProvider).DeliveredBidTrace(ctx, *filter.Slot)
+			if err != nil || trace == nil {
+				return nil, err
+			}
+
+			return []*v1.BidTrace{trace}, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := make(map[phase0.Hash32]*server.Sourced[*v1.BidTrace])
+	res := make([]*server.Sourced[*v1.BidTrace], 0)
+	for _, result := range results {
+		for _, trace := range result.res {
+			if trace == nil {
+				continue
+			}
+			// Relays filter by the query, but do not trust them to do so.
+			if (filter.Slot != nil && trace.Slot != *filter.Slot) ||
+				(filter.Cursor != nil && trace.Slot > *filter.Cursor) ||
+				(filter.BlockHash != nil && trace.BlockHash != *filter.BlockHash) ||
+				(filter.ProposerPubkey != nil && trace.ProposerPubkey != *filter.ProposerPubkey) ||
+				(filter.BuilderPubkey != nil && trace.BuilderPubkey != *filter.BuilderPubkey) {
+				continue
+			}
+			if existing, exists := merged[trace.BlockHash]; exists {
+				if !slices.Contains(existing.Relays, result.relay) {
+					existing.Relays = append(existing.Relays, result.relay)
+				}
+				continue
+			}
+			merged[trace.BlockHash] = &server.Sourced[*v1.BidTrace]{
+				Record: trace,
+				Relays: []string{result.relay},
+			}
+			res = append(res, merged[trace.BlockHash])
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		switch filter.OrderBy {
+		case server.OrderByValueAscending:
+			return compareValues(res[i].Record.Value, res[j].Record.Value) < 0
+		case server.OrderByValueDescending:
+			return compareValues(res[i].Record.Value, res[j].Record.Value) > 0
+		default:
+			return res[i].Record.Slot > res[j].Record.Slot
+		}
+	})
+
+	return limit(res, filter.Limit), nil
+}
+
+// SourcedReceivedBidTraces provides the received bid traces that match the
+// filter, ordered by the time they were first received by any relay.  The
+// query is forwarded to each relay that supports data API queries; relays
+// that can only be queried by slot are used when the filter is for a slot
+// without a block number.  Results are merged and limited as requested.
+func (s *Service) SourcedReceivedBidTraces(ctx context.Context,
+	filter *server.ReceivedBidTracesFilter,
+) (
+	[]*server.Sourced[*v1.BidTraceWithTimestamp],
+	error,
+) {
+	bySlot := filter.Slot != nil && filter.BlockNumber == nil
+	relays, err := capableRelays[client.ReceivedBidTracesQueryProvider, client.ReceivedBidTracesProvider](s.relays, bySlot, "received bid traces")
+	if err != nil {
+		return nil, err
+	}
+
+	query := receivedBidTracesQuery(filter)
+	results, err := fanOut(ctx, relays, "received bid traces",
+		func(ctx context.Context, relay client.Service) ([]*v1.BidTraceWithTimestamp, error) {
+			if provider, isProvider := relay.(client.ReceivedBidTracesQueryProvider); isProvider {
+				return provider.QueryReceivedBidTraces(ctx, query)
+			}
+
+			return relay.(client.ReceivedBidTracesProvider).ReceivedBidTraces(ctx, *filter.Slot)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := make(map[phase0.Hash32]*server.Sourced[*v1.BidTraceWithTimestamp])
+	for _, result := range results {
+		for _, trace := range result.res {
+			if trace == nil {
+				continue
+			}
+			// Relays filter by the query, but do not trust them to do so.
+			if (filter.Slot != nil && trace.Slot != *filter.Slot) ||
+				(filter.BlockHash != nil && trace.BlockHash != *filter.BlockHash) ||
+				(filter.BuilderPubkey != nil && trace.BuilderPubkey != *filter.BuilderPubkey) {
+				continue
+			}
+			existing, exists := merged[trace.BlockHash]
+			if !exists {
+				merged[trace.BlockHash] = &server.Sourced[*v1.BidTraceWithTimestamp]{
+					Record: trace,
+					Relays: []string{result.relay},
+				}
+				continue
+			}
+			if trace.Timestamp.Before(existing.Record.Timestamp) {
+				existing.Record = trace
+			}
+			// A relay can report the same block more than once.
+			if !slices.Contains(existing.Relays, result.relay) {
+				existing.Relays = append(existing.Relays, result.relay)
+			}
+		}
+	}
+
+	res := make([]*server.Sourced[*v1.BidTraceWithTimestamp], 0, len(merged))
+	for _, trace := range merged {
+		res = append(res, trace)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if !res[i].Record.Timestamp.Equal(res[j].Record.Timestamp) {
+			return res[i].Record.Timestamp.Before(res[j].Record.Timestamp)
+		}
+
+		return bytes.Compare(res[i].Record.BlockHash[:], res[j].Record.BlockHash[:]) < 0
+	})
+
+	return limit(res, filter.Limit), nil
+}
+
+// capableRelays returns the relays that provide Q, along with those that
+// provide S if bySlot is set.
+func capableRelays[Q client.Service, S client.Service](relays []client.Service,
+	bySlot bool,
+	data string,
+) (
+	[]client.Service,
+	error,
+) {
+	res := make([]client.Service, 0, len(relays))
+	slotOnly := false
+	for _, relay := range relays {
+		if _, isProvider := relay.(Q); isProvider {
+			res = append(res, relay)
+			continue
+		}
+		if _, isProvider := relay.(S); isProvider {
+			slotOnly = true
+			if bySlot {
+				res = append(res, relay)
+			}
+		}
+	}
+
+	if len(res) == 0 {
+		if slotOnly {
+			return nil, fmt.Errorf("%w: relays can only be queried for %s by slot without block_number", server.ErrUnsupportedQuery, data)
+		}
+
+		return nil, fmt.Errorf("no relays provide %s", data)
+	}
+
+	return res, nil
+}
+
+// deliveredBidTracesQuery returns the data API query for the filter.
+func deliveredBidTracesQuery(filter *server.DeliveredBidTracesFilter) url.Values {
+	query := url.Values{}
+	if filter.Slot != nil {
+		query.Set("slot", fmt.Sprintf("%d", *filter.Slot))
+	}
+	if filter.Cursor != nil {
+		query.Set("cursor", fmt.Sprintf("%d", *filter.Cursor))
+	}
+	if filter.BlockHash != nil {
+		query.Set("block_hash", fmt.Sprintf("%#x", *filter.BlockHash))
+	}
+	if filter.BlockNumber != nil {
+		query.Set("block_number", fmt.Sprintf("%d", *filter.BlockNumber))
+	}
+	if filter.ProposerPubkey != nil {
+		query.Set("proposer_pubkey", fmt.Sprintf("%#x", *filter.ProposerPubkey))
+	}
+	if filter.BuilderPubkey != nil {
+		query.Set("builder_pubkey", fmt.Sprintf("%#x", *filter.BuilderPubkey))
+	}
+	if filter.OrderBy != server.OrderBySlotDescending {
+		query.Set("order_by", filter.OrderBy.String())
+	}
+	if filter.Limit > 0 {
+		query.Set("limit", fmt.Sprintf("%d", filter.Limit))
+	}
+
+	return query
+}
+
+// receivedBidTracesQuery returns the data API query for the filter.
+func receivedBidTracesQuery(filter *server.ReceivedBidTracesFilter) url.Values {
+	query := url.Values{}
+	if filter.Slot != nil {
+		query.Set("slot", fmt.Sprintf("%d", *filter.Slot))
+	}
+	if filter.BlockHash != nil {
+		query.Set("block_hash", fmt.Sprintf("%#x", *filter.BlockHash))
+	}
+	if filter.BlockNumber != nil {
+		query.Set("block_number", fmt.Sprintf("%d", *filter.BlockNumber))
+	}
+	if filter.BuilderPubkey != nil {
+		query.Set("builder_pubkey", fmt.Sprintf("%#x", *filter.BuilderPubkey))
+	}
+	if filter.Limit > 0 {
+		query.Set("limit", fmt.Sprintf("%d", filter.Limit))
+	}
+
+	return query
+}
+
+// limit truncates records to the limit, if it is set.
+func limit[T any](records []T, maxRecords uint64) []T {
+	if maxRecords > 0 && uint64(len(records)) > maxRecords {
+		return records[:maxRecords]
+	}
+
+	return records
+}
+
+// compareValues compares two values, treating nil as zero.
+func compareValues(a *big.Int, b *big.Int) int {
+	if a == nil {
+		a = big.NewInt(0)
+	}
+	if b == nil {
+		b = big.NewInt(0)
+	}
+
+	return a.Cmp(b)
+}
+
+// records strips the sources from sourced records.
+func records[T any](sourced []*server.Sourced[T]) []T {
+	res := make([]T, len(sourced))
+	for i := range sourced {
+		res[i] = sourced[i].Record
+	}
+
+	return res
+}
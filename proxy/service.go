@@ -0,0 +1,123 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package proxy provides a store for the relay data API server that answers
+// each query from multiple relays, merging their results.
+//
+// Queries are forwarded to each relay with their data API query parameters,
+// and the merged results are ordered and limited as requested.  Relays that
+// can only be queried by slot are included in queries for a slot.
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	client "github.com/attestantio/go-relay-client"
+	"github.com/attestantio/go-relay-client/server"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is an aggregating store over multiple relays.
+type Service struct {
+	relays []client.Service
+}
+
+// Ensure that the service can be used as a store by the server.
+var _ server.SourcedStore = (*Service)(nil)
+
+// log is a service-wide logger.
+var log zerolog.Logger
+
+// New creates a new aggregating proxy store.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "proxy").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		relays: parameters.relays,
+	}, nil
+}
+
+// relayResult is the result of a query to a single relay.
+type relayResult[T any] struct {
+	relay string
+	res   T
+}
+
+// fanOut runs the query against all relays that provide P concurrently,
+// returning the results from those that succeed.  It returns an error only
+// if no relay provides P, or all relays that do fail.
+func fanOut[P client.Service, T any](ctx context.Context,
+	relays []client.Service,
+	data string,
+	query func(context.Context, P) (T, error),
+) (
+	[]*relayResult[T],
+	error,
+) {
+	providers := make([]P, 0, len(relays))
+	for _, relay := range relays {
+		if provider, isProvider := relay.(P); isProvider {
+			providers = append(providers, provider)
+		}
+	}
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("no relays provide %s", data)
+	}
+
+	results := make([]*relayResult[T], len(providers))
+	errs := make([]error, len(providers))
+	var wg sync.WaitGroup
+	for i := range providers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res, err := query(ctx, providers[i])
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			results[i] = &relayResult[T]{
+				relay: providers[i].Name(),
+				res:   res,
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := make([]*relayResult[T], 0, len(providers))
+	for i := range providers {
+		if errs[i] != nil {
+			log.Warn().Str("relay", providers[i].Name()).Err(errs[i]).Str("data", data).Msg("Failed to obtain data from relay")
+			continue
+		}
+		succeeded = append(succeeded, results[i])
+	}
+	if len(succeeded) == 0 {
+		return nil, errors.Wrap(errs[0], fmt.Sprintf("failed to obtain %s from any relay", data))
+	}
+
+	return succeeded, nil
+}
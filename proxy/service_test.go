@@ -0,0 +1,408 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	nethttp "net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	builderv1 "github.com/attestantio/go-builder-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/http"
+	"github.com/attestantio/go-relay-client/proxy"
+	"github.com/attestantio/go-relay-client/server"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// relay is a mock relay.
+type relay struct {
+	name      string
+	proposers []*v1.QueuedProposer
+	delivered *v1.BidTrace
+	received  []*v1.BidTraceWithTimestamp
+	err       error
+}
+
+func (r *relay) Name() string              { return r.name }
+func (r *relay) Address() string           { return r.name }
+func (r *relay) Pubkey() *phase0.BLSPubKey { return nil }
+
+func (r *relay) QueuedProposers(_ context.Context) ([]*v1.QueuedProposer, error) {
+	return r.proposers, r.err
+}
+
+func (r *relay) DeliveredBidTrace(_ context.Context, _ phase0.Slot) (*v1.BidTrace, error) {
+	return r.delivered, r.err
+}
+
+func (r *relay) ReceivedBidTraces(_ context.Context, _ phase0.Slot) ([]*v1.BidTraceWithTimestamp, error) {
+	return r.received, r.err
+}
+
+// queryRelay is a mock relay that supports data API queries.
+type queryRelay struct {
+	name      string
+	delivered []*v1.BidTrace
+	received  []*v1.BidTraceWithTimestamp
+	queries   []string
+}
+
+func (r *queryRelay) Name() string              { return r.name }
+func (r *queryRelay) Address() string           { return r.name }
+func (r *queryRelay) Pubkey() *phase0.BLSPubKey { return nil }
+
+func (r *queryRelay) QueryDeliveredBidTraces(_ context.Context, query url.Values) ([]*v1.BidTrace, error) {
+	r.queries = append(r.queries, query.Encode())
+
+	return r.delivered, nil
+}
+
+func (r *queryRelay) QueryReceivedBidTraces(_ context.Context, query url.Values) ([]*v1.BidTraceWithTimestamp, error) {
+	r.queries = append(r.queries, query.Encode())
+
+	return r.received, nil
+}
+
+// basicRelay is a mock relay that provides no data.
+type basicRelay struct{}
+
+func (*basicRelay) Name() string              { return "basic" }
+func (*basicRelay) Address() string           { return "basic" }
+func (*basicRelay) Pubkey() *phase0.BLSPubKey { return nil }
+
+func proposer(slot phase0.Slot, pubkey byte, timestamp int64) *v1.QueuedProposer {
+	return &v1.QueuedProposer{
+		Slot: slot,
+		Entry: &builderv1.SignedValidatorRegistration{
+			Message: &builderv1.ValidatorRegistration{
+				Pubkey:    phase0.BLSPubKey{pubkey},
+				GasLimit:  30000000,
+				Timestamp: time.Unix(timestamp, 0),
+			},
+		},
+	}
+}
+
+func bid(blockHash byte, value int64, timestampMs int64) *v1.BidTraceWithTimestamp {
+	return &v1.BidTraceWithTimestamp{
+		Slot:      100,
+		BlockHash: phase0.Hash32{blockHash},
+		Value:     big.NewInt(value),
+		Timestamp: time.UnixMilli(timestampMs),
+	}
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := proxy.New(ctx)
+	require.EqualError(t, err, "problem with parameters: no relays specified")
+
+	_, err = proxy.New(ctx, proxy.WithRelays([]client.Service{nil}))
+	require.EqualError(t, err, "problem with parameters: nil relay specified")
+
+	_, err = proxy.New(ctx, proxy.WithRelays([]client.Service{&relay{name: "a"}}))
+	require.NoError(t, err)
+}
+
+func TestQueuedProposers(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := proxy.New(ctx, proxy.WithRelays([]client.Service{
+		&relay{name: "a", proposers: []*v1.QueuedProposer{proposer(2, 0x01, 100), proposer(1, 0x02, 100)}},
+		&relay{name: "b", proposers: []*v1.QueuedProposer{proposer(2, 0x01, 200)}},
+		&relay{name: "c", err: errors.New("relay down")},
+		&basicRelay{},
+	}))
+	require.NoError(t, err)
+
+	proposers, err := s.SourcedQueuedProposers(ctx)
+	require.NoError(t, err)
+	require.Len(t, proposers, 2)
+	require.Equal(t, phase0.Slot(1), proposers[0].Record.Slot)
+	require.Equal(t, []string{"a"}, proposers[0].Relays)
+	require.Equal(t, phase0.Slot(2), proposers[1].Record.Slot)
+	require.Equal(t, int64(200), proposers[1].Record.Entry.Message.Timestamp.Unix())
+	require.ElementsMatch(t, []string{"a", "b"}, proposers[1].Relays)
+
+	plain, err := s.QueuedProposers(ctx)
+	require.NoError(t, err)
+	require.Len(t, plain, 2)
+	require.Equal(t, proposers[1].Record, plain[1])
+}
+
+func TestDeliveredBidTraces(t *testing.T) {
+	ctx := context.Background()
+	slot := phase0.Slot(100)
+
+	delivered := &v1.BidTrace{Slot: slot, BlockHash: phase0.Hash32{0x01}, BuilderPubkey: phase0.BLSPubKey{0x02}, Value: big.NewInt(5)}
+	other := &v1.BidTrace{Slot: slot, BlockHash: phase0.Hash32{0x03}, Value: big.NewInt(10)}
+	s, err := proxy.New(ctx, proxy.WithRelays([]client.Service{
+		&relay{name: "a", delivered: delivered},
+		&relay{name: "b", delivered: delivered},
+		&relay{name: "c", delivered: other},
+		&relay{name: "d"},
+	}))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		filter *server.DeliveredBidTracesFilter
+		hashes []phase0.Hash32
+		relays [][]string
+		err    string
+	}{
+		{
+			name:   "SlotMissing",
+			filter: &server.DeliveredBidTracesFilter{},
+			err:    "unsupported query: relays can only be queried for delivered bid traces by slot without block_number",
+		},
+		{
+			name:   "BlockNumber",
+			filter: &server.DeliveredBidTracesFilter{Slot: &slot, BlockNumber: new(uint64)},
+			err:    "unsupported query: relays can only be queried for delivered bid traces by slot without block_number",
+		},
+		{
+			name:   "ValueDescending",
+			filter: &server.DeliveredBidTracesFilter{Slot: &slot, OrderBy: server.OrderByValueDescending},
+			hashes: []phase0.Hash32{{0x03}, {0x01}},
+			relays: [][]string{{"c"}, {"a", "b"}},
+		},
+		{
+			name:   "ValueAscending",
+			filter: &server.DeliveredBidTracesFilter{Slot: &slot, OrderBy: server.OrderByValueAscending},
+			hashes: []phase0.Hash32{{0x01}, {0x03}},
+			relays: [][]string{{"a", "b"}, {"c"}},
+		},
+		{
+			name:   "BuilderPubkey",
+			filter: &server.DeliveredBidTracesFilter{Slot: &slot, BuilderPubkey: &phase0.BLSPubKey{0x02}},
+			hashes: []phase0.Hash32{{0x01}},
+			relays: [][]string{{"a", "b"}},
+		},
+		{
+			name:   "BlockHash",
+			filter: &server.DeliveredBidTracesFilter{Slot: &slot, BlockHash: &phase0.Hash32{0x04}},
+			hashes: []phase0.Hash32{},
+			relays: [][]string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			traces, err := s.SourcedDeliveredBidTraces(ctx, test.filter)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.ErrorIs(t, err, server.ErrUnsupportedQuery)
+				return
+			}
+			require.NoError(t, err)
+			hashes := make([]phase0.Hash32, len(traces))
+			relays := make([][]string, len(traces))
+			for i := range traces {
+				hashes[i] = traces[i].Record.BlockHash
+				relays[i] = traces[i].Relays
+			}
+			require.Equal(t, test.hashes, hashes)
+			require.Equal(t, test.relays, relays)
+		})
+	}
+}
+
+func TestReceivedBidTraces(t *testing.T) {
+	ctx := context.Background()
+	slot := phase0.Slot(100)
+
+	s, err := proxy.New(ctx, proxy.WithRelays([]client.Service{
+		&relay{name: "a", received: []*v1.BidTraceWithTimestamp{bid(0x01, 1, 3000), bid(0x02, 2, 2000), bid(0x01, 1, 3500)}},
+		&relay{name: "b", received: []*v1.BidTraceWithTimestamp{bid(0x01, 1, 1000)}},
+	}))
+	require.NoError(t, err)
+
+	traces, err := s.SourcedReceivedBidTraces(ctx, &server.ReceivedBidTracesFilter{Slot: &slot})
+	require.NoError(t, err)
+	require.Len(t, traces, 2)
+	require.Equal(t, phase0.Hash32{0x01}, traces[0].Record.BlockHash)
+	require.Equal(t, int64(1000), traces[0].Record.Timestamp.UnixMilli())
+	require.Equal(t, []string{"a", "b"}, traces[0].Relays)
+	require.Equal(t, phase0.Hash32{0x02}, traces[1].Record.BlockHash)
+	require.Equal(t, []string{"a"}, traces[1].Relays)
+
+	_, err = s.SourcedReceivedBidTraces(ctx, &server.ReceivedBidTracesFilter{BuilderPubkey: &phase0.BLSPubKey{}})
+	require.EqualError(t, err, "unsupported query: relays can only be queried for received bid traces by slot without block_number")
+}
+
+func TestDeliveredBidTracesQuery(t *testing.T) {
+	ctx := context.Background()
+	cursor := phase0.Slot(200)
+
+	a := &queryRelay{name: "a", delivered: []*v1.BidTrace{
+		{Slot: 200, BlockHash: phase0.Hash32{0x01}, Value: big.NewInt(1)},
+		{Slot: 198, BlockHash: phase0.Hash32{0x03}, Value: big.NewInt(3)},
+		// Beyond the cursor, so ignored.
+		{Slot: 201, BlockHash: phase0.Hash32{0x04}, Value: big.NewInt(4)},
+	}}
+	b := &queryRelay{name: "b", delivered: []*v1.BidTrace{
+		{Slot: 200, BlockHash: phase0.Hash32{0x01}, Value: big.NewInt(1)},
+		{Slot: 199, BlockHash: phase0.Hash32{0x02}, Value: big.NewInt(2)},
+	}}
+	s, err := proxy.New(ctx, proxy.WithRelays([]client.Service{
+		a,
+		b,
+		// Cannot answer a query without a slot, so not used.
+		&relay{name: "c", delivered: &v1.BidTrace{Slot: 200, BlockHash: phase0.Hash32{0x05}}},
+	}))
+	require.NoError(t, err)
+
+	traces, err := s.SourcedDeliveredBidTraces(ctx, &server.DeliveredBidTracesFilter{
+		Cursor:        &cursor,
+		BuilderPubkey: &phase0.BLSPubKey{},
+		Limit:         2,
+	})
+	require.NoError(t, err)
+	expectedQuery := "builder_pubkey=0x" + strings.Repeat("00", 48) + "&cursor=200&limit=2"
+	require.Equal(t, []string{expectedQuery}, a.queries)
+	require.Equal(t, []string{expectedQuery}, b.queries)
+	require.Len(t, traces, 2)
+	require.Equal(t, phase0.Hash32{0x01}, traces[0].Record.BlockHash)
+	require.Equal(t, []string{"a", "b"}, traces[0].Relays)
+	require.Equal(t, phase0.Hash32{0x02}, traces[1].Record.BlockHash)
+	require.Equal(t, []string{"b"}, traces[1].Relays)
+
+	traces, err = s.SourcedDeliveredBidTraces(ctx, &server.DeliveredBidTracesFilter{
+		Cursor:  &cursor,
+		OrderBy: server.OrderByValueDescending,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "cursor=200&order_by=-value", a.queries[1])
+	require.Len(t, traces, 3)
+	require.Equal(t, phase0.Hash32{0x03}, traces[0].Record.BlockHash)
+}
+
+func TestReceivedBidTracesQuery(t *testing.T) {
+	ctx := context.Background()
+	blockNumber := uint64(1000)
+
+	a := &queryRelay{name: "a", received: []*v1.BidTraceWithTimestamp{bid(0x01, 1, 3000), bid(0x02, 2, 2000)}}
+	b := &queryRelay{name: "b", received: []*v1.BidTraceWithTimestamp{bid(0x01, 1, 1000)}}
+	s, err := proxy.New(ctx, proxy.WithRelays([]client.Service{a, b}))
+	require.NoError(t, err)
+
+	traces, err := s.SourcedReceivedBidTraces(ctx, &server.ReceivedBidTracesFilter{BlockNumber: &blockNumber, Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{"block_number=1000&limit=1"}, a.queries)
+	require.Len(t, traces, 1)
+	require.Equal(t, phase0.Hash32{0x01}, traces[0].Record.BlockHash)
+	require.Equal(t, int64(1000), traces[0].Record.Timestamp.UnixMilli())
+	require.Equal(t, []string{"a", "b"}, traces[0].Relays)
+}
+
+func TestErrors(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := proxy.New(ctx, proxy.WithRelays([]client.Service{&basicRelay{}}))
+	require.NoError(t, err)
+	_, err = s.QueuedProposers(ctx)
+	require.EqualError(t, err, "no relays provide queued proposers")
+
+	s, err = proxy.New(ctx, proxy.WithRelays([]client.Service{
+		&relay{name: "a", err: errors.New("relay a down")},
+		&relay{name: "b", err: errors.New("relay b down")},
+	}))
+	require.NoError(t, err)
+	_, err = s.QueuedProposers(ctx)
+	require.EqualError(t, err, "failed to obtain queued proposers from any relay: relay a down")
+}
+
+// TestServer ensures that the proxy serves relay-compatible records, annotated
+// with their source relays only on request.
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+
+	delivered := &v1.BidTrace{Slot: 100, BlockHash: phase0.Hash32{0x01}, Value: big.NewInt(5)}
+	store, err := proxy.New(ctx, proxy.WithRelays([]client.Service{
+		&relay{name: "a", delivered: delivered},
+		&relay{name: "b", delivered: delivered},
+	}))
+	require.NoError(t, err)
+	s, err := server.New(ctx,
+		server.WithListenAddress("127.0.0.1:0"),
+		server.WithStore(store),
+	)
+	require.NoError(t, err)
+
+	resp, err := nethttp.Get("http://" + s.Address() + "/relay/v1/data/bidtraces/proposer_payload_delivered?slot=100")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, nethttp.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	// The response is the same as that of a relay.
+	var fields []map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(body, &fields))
+	require.Len(t, fields, 1)
+	require.NotContains(t, fields[0], "relays")
+
+	// The response is readable by a client with strict decoding.
+	relayClient, err := http.New(ctx,
+		http.WithAddress("http://"+s.Address()),
+		http.WithTimeout(5*time.Second),
+		http.WithStrictDecoding(true),
+	)
+	require.NoError(t, err)
+	trace, err := relayClient.(client.DeliveredBidTraceProvider).DeliveredBidTrace(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, delivered, trace)
+
+	// The source relays are returned on request.
+	resp, err = nethttp.Get("http://" + s.Address() + "/relay/v1/data/bidtraces/proposer_payload_delivered?slot=100&include_relays=true")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, nethttp.StatusOK, resp.StatusCode)
+	body, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var traces []*v1.BidTrace
+	require.NoError(t, json.Unmarshal(body, &traces))
+	require.Equal(t, []*v1.BidTrace{delivered}, traces)
+	var annotated []struct {
+		Relays []string `json:"relays"`
+	}
+	require.NoError(t, json.Unmarshal(body, &annotated))
+	require.Len(t, annotated, 1)
+	require.Equal(t, []string{"a", "b"}, annotated[0].Relays)
+
+	resp, err = nethttp.Get("http://" + s.Address() + "/relay/v1/data/bidtraces/proposer_payload_delivered?slot=100&include_relays=maybe")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, nethttp.StatusBadRequest, resp.StatusCode)
+
+	resp, err = nethttp.Get("http://" + s.Address() + "/relay/v1/data/bidtraces/proposer_payload_delivered")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, nethttp.StatusBadRequest, resp.StatusCode)
+	body, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"code":400,"message":"unsupported query: relays can only be queried for delivered bid traces by slot without block_number"}`, string(body))
+}
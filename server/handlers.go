@@ -0,0 +1,362 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxDeliveredBidTraces is the maximum number of delivered bid traces returned by a single request.
+	maxDeliveredBidTraces = 200
+	// maxReceivedBidTraces is the maximum number of received bid traces returned by a single request.
+	maxReceivedBidTraces = 500
+)
+
+// errorResponse is the body returned for unsuccessful requests.
+type errorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (s *Service) queuedProposers(w http.ResponseWriter, r *http.Request) {
+	if !checkMethod(w, r) {
+		return
+	}
+
+	includeRelays, err := parseIncludeRelays(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if sourcedStore, isSourcedStore := s.store.(SourcedStore); isSourcedStore && includeRelays {
+		proposers, err := sourcedStore.SourcedQueuedProposers(r.Context())
+		if err != nil {
+			writeStoreError(w, err, "failed to obtain queued proposers")
+			return
+		}
+		writeJSON(w, proposers)
+		return
+	}
+
+	proposers, err := s.store.QueuedProposers(r.Context())
+	if err != nil {
+		writeStoreError(w, err, "failed to obtain queued proposers")
+		return
+	}
+
+	writeJSON(w, proposers)
+}
+
+func (s *Service) deliveredBidTraces(w http.ResponseWriter, r *http.Request) {
+	if !checkMethod(w, r) {
+		return
+	}
+
+	filter, err := parseDeliveredBidTracesFilter(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	includeRelays, err := parseIncludeRelays(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if sourcedStore, isSourcedStore := s.store.(SourcedStore); isSourcedStore && includeRelays {
+		traces, err := sourcedStore.SourcedDeliveredBidTraces(r.Context(), filter)
+		if err != nil {
+			writeStoreError(w, err, "failed to obtain delivered bid traces")
+			return
+		}
+		writeJSON(w, limit(traces, filter.Limit))
+		return
+	}
+
+	traces, err := s.store.DeliveredBidTraces(r.Context(), filter)
+	if err != nil {
+		writeStoreError(w, err, "failed to obtain delivered bid traces")
+		return
+	}
+
+	writeJSON(w, limit(traces, filter.Limit))
+}
+
+func (s *Service) receivedBidTraces(w http.ResponseWriter, r *http.Request) {
+	if !checkMethod(w, r) {
+		return
+	}
+
+	filter, err := parseReceivedBidTracesFilter(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	includeRelays, err := parseIncludeRelays(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if sourcedStore, isSourcedStore := s.store.(SourcedStore); isSourcedStore && includeRelays {
+		traces, err := sourcedStore.SourcedReceivedBidTraces(r.Context(), filter)
+		if err != nil {
+			writeStoreError(w, err, "failed to obtain received bid traces")
+			return
+		}
+		writeJSON(w, limit(traces, filter.Limit))
+		return
+	}
+
+	traces, err := s.store.ReceivedBidTraces(r.Context(), filter)
+	if err != nil {
+		writeStoreError(w, err, "failed to obtain received bid traces")
+		return
+	}
+
+	writeJSON(w, limit(traces, filter.Limit))
+}
+
+// limit truncates items to at most the given number.
+func limit[T any](items []T, maxItems uint64) []T {
+	if uint64(len(items)) > maxItems {
+		return items[:maxItems]
+	}
+
+	return items
+}
+
+func parseDeliveredBidTracesFilter(query url.Values) (*DeliveredBidTracesFilter, error) {
+	filter := &DeliveredBidTracesFilter{
+		Limit: maxDeliveredBidTraces,
+	}
+
+	var err error
+	if filter.Slot, err = parseSlot(query, "slot"); err != nil {
+		return nil, err
+	}
+	if filter.Cursor, err = parseSlot(query, "cursor"); err != nil {
+		return nil, err
+	}
+	if filter.Slot != nil && filter.Cursor != nil {
+		return nil, errors.New("cannot specify both slot and cursor")
+	}
+	if filter.BlockHash, err = parseHash(query, "block_hash"); err != nil {
+		return nil, err
+	}
+	if filter.BlockNumber, err = parseUint64(query, "block_number"); err != nil {
+		return nil, err
+	}
+	if filter.ProposerPubkey, err = parsePubkey(query, "proposer_pubkey"); err != nil {
+		return nil, err
+	}
+	if filter.BuilderPubkey, err = parsePubkey(query, "builder_pubkey"); err != nil {
+		return nil, err
+	}
+	if filter.Limit, err = parseLimit(query, maxDeliveredBidTraces); err != nil {
+		return nil, err
+	}
+
+	switch query.Get("order_by") {
+	case "":
+		filter.OrderBy = OrderBySlotDescending
+	case "value":
+		filter.OrderBy = OrderByValueAscending
+	case "-value":
+		filter.OrderBy = OrderByValueDescending
+	default:
+		return nil, errors.New("invalid order_by")
+	}
+
+	return filter, nil
+}
+
+func parseReceivedBidTracesFilter(query url.Values) (*ReceivedBidTracesFilter, error) {
+	filter := &ReceivedBidTracesFilter{}
+
+	var err error
+	if filter.Slot, err = parseSlot(query, "slot"); err != nil {
+		return nil, err
+	}
+	if filter.BlockHash, err = parseHash(query, "block_hash"); err != nil {
+		return nil, err
+	}
+	if filter.BlockNumber, err = parseUint64(query, "block_number"); err != nil {
+		return nil, err
+	}
+	if filter.BuilderPubkey, err = parsePubkey(query, "builder_pubkey"); err != nil {
+		return nil, err
+	}
+	if filter.Slot == nil && filter.BlockHash == nil && filter.BlockNumber == nil && filter.BuilderPubkey == nil {
+		return nil, errors.New("need to query for specific slot or block_hash or block_number or builder_pubkey")
+	}
+	if filter.Limit, err = parseLimit(query, maxReceivedBidTraces); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+// parseIncludeRelays parses the include_relays flag, which requests that
+// records are annotated with the relays from which they were obtained.
+func parseIncludeRelays(query url.Values) (bool, error) {
+	if !query.Has("include_relays") {
+		return false, nil
+	}
+	val, err := strconv.ParseBool(query.Get("include_relays"))
+	if err != nil {
+		return false, errors.New("invalid include_relays")
+	}
+
+	return val, nil
+}
+
+func parseUint64(query url.Values, name string) (*uint64, error) {
+	if !query.Has(name) {
+		return nil, nil
+	}
+	val, err := strconv.ParseUint(query.Get(name), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s", name)
+	}
+
+	return &val, nil
+}
+
+func parseSlot(query url.Values, name string) (*phase0.Slot, error) {
+	val, err := parseUint64(query, name)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	slot := phase0.Slot(*val)
+
+	return &slot, nil
+}
+
+func parseLimit(query url.Values, maxLimit uint64) (uint64, error) {
+	limit, err := parseUint64(query, "limit")
+	if err != nil {
+		return 0, err
+	}
+	if limit == nil {
+		return maxLimit, nil
+	}
+	if *limit > maxLimit {
+		return 0, fmt.Errorf("maximum limit is %d", maxLimit)
+	}
+
+	return *limit, nil
+}
+
+func parseHex(query url.Values, name string, length int) ([]byte, error) {
+	if !query.Has(name) {
+		return nil, nil
+	}
+	data, err := hex.DecodeString(strings.TrimPrefix(query.Get(name), "0x"))
+	if err != nil || len(data) != length {
+		return nil, fmt.Errorf("invalid %s", name)
+	}
+
+	return data, nil
+}
+
+func parseHash(query url.Values, name string) (*phase0.Hash32, error) {
+	data, err := parseHex(query, name, phase0.Hash32Length)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	hash := phase0.Hash32(data)
+
+	return &hash, nil
+}
+
+func parsePubkey(query url.Values, name string) (*phase0.BLSPubKey, error) {
+	data, err := parseHex(query, name, phase0.PublicKeyLength)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	pubkey := phase0.BLSPubKey(data)
+
+	return &pubkey, nil
+}
+
+// checkMethod ensures that the request is a GET, writing an error if not.
+func checkMethod(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return false
+	}
+
+	return true
+}
+
+func writeJSON[T any](w http.ResponseWriter, items []T) {
+	if items == nil {
+		// Always return an array.
+		items = make([]T, 0)
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to marshal response")
+		writeError(w, http.StatusInternalServerError, "failed to marshal response")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(data); err != nil {
+		log.Debug().Err(err).Msg("Failed to write response")
+	}
+}
+
+// writeStoreError writes an error returned by the store.  Unsupported queries
+// are reported as bad requests, and anything else as an internal error
+// without exposing the details.
+func writeStoreError(w http.ResponseWriter, err error, message string) {
+	if errors.Is(err, ErrUnsupportedQuery) {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	log.Error().Err(err).Str("response", message).Msg("Store failed")
+	writeError(w, http.StatusInternalServerError, message)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	data, err := json.Marshal(&errorResponse{
+		Code:    statusCode,
+		Message: message,
+	})
+	if err != nil {
+		// Cannot happen.
+		data = []byte(`{}`)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if _, err := w.Write(data); err != nil {
+		log.Debug().Err(err).Msg("Failed to write error response")
+	}
+}
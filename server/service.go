@@ -0,0 +1,100 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package server serves relay data over the relay data API, from a store.
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a relay data API server.
+type Service struct {
+	store    Store
+	listener net.Listener
+	server   *http.Server
+	mux      *http.ServeMux
+}
+
+// log is a service-wide logger.
+var log zerolog.Logger
+
+// New creates a new server, which serves until the context is done.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "server").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	listener, err := net.Listen("tcp", parameters.listenAddress)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to listen")
+	}
+
+	s := &Service{
+		store:    parameters.store,
+		listener: listener,
+		mux:      http.NewServeMux(),
+	}
+	s.mux.HandleFunc("/relay/v1/builder/validators", s.queuedProposers)
+	s.mux.HandleFunc("/relay/v1/data/bidtraces/proposer_payload_delivered", s.deliveredBidTraces)
+	s.mux.HandleFunc("/relay/v1/data/bidtraces/builder_blocks_received", s.receivedBidTraces)
+	s.server = &http.Server{
+		Handler:           http.TimeoutHandler(s.mux, parameters.timeout, ""),
+		ReadHeaderTimeout: parameters.timeout,
+	}
+
+	go func() {
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Err(err).Msg("Server failed")
+		}
+	}()
+
+	// Close the server on context done.
+	go func(s *Service) {
+		<-ctx.Done()
+		log.Trace().Msg("Context done; shutting down server")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := s.server.Shutdown(shutdownCtx); err != nil {
+			log.Warn().Err(err).Msg("Failed to shut down server cleanly")
+		}
+	}(s)
+
+	log.Trace().Str("address", listener.Addr().String()).Msg("Server started")
+
+	return s, nil
+}
+
+// Address returns the address on which the server is listening.
+func (s *Service) Address() string {
+	return s.listener.Addr().String()
+}
+
+// ServeHTTP serves a request, allowing the server to be mounted in another handler.
+func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
@@ -0,0 +1,351 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"context"
+	"io"
+	"math/big"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	builderv1 "github.com/attestantio/go-builder-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/http"
+	"github.com/attestantio/go-relay-client/server"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// store is a mock store that records the filters it is given.
+type store struct {
+	proposers       []*v1.QueuedProposer
+	delivered       []*v1.BidTrace
+	received        []*v1.BidTraceWithTimestamp
+	err             error
+	deliveredFilter *server.DeliveredBidTracesFilter
+	receivedFilter  *server.ReceivedBidTracesFilter
+}
+
+func (s *store) QueuedProposers(_ context.Context) ([]*v1.QueuedProposer, error) {
+	return s.proposers, s.err
+}
+
+func (s *store) DeliveredBidTraces(_ context.Context, filter *server.DeliveredBidTracesFilter) ([]*v1.BidTrace, error) {
+	s.deliveredFilter = filter
+	if s.err != nil {
+		return nil, s.err
+	}
+	res := make([]*v1.BidTrace, 0)
+	for _, trace := range s.delivered {
+		if filter.Slot == nil || *filter.Slot == trace.Slot {
+			res = append(res, trace)
+		}
+	}
+
+	return res, nil
+}
+
+func (s *store) ReceivedBidTraces(_ context.Context, filter *server.ReceivedBidTracesFilter) ([]*v1.BidTraceWithTimestamp, error) {
+	s.receivedFilter = filter
+	if s.err != nil {
+		return nil, s.err
+	}
+	res := make([]*v1.BidTraceWithTimestamp, 0)
+	for _, trace := range s.received {
+		if filter.Slot == nil || *filter.Slot == trace.Slot {
+			res = append(res, trace)
+		}
+	}
+
+	return res, nil
+}
+
+func testStore() *store {
+	return &store{
+		proposers: []*v1.QueuedProposer{
+			{
+				Slot: 100,
+				Entry: &builderv1.SignedValidatorRegistration{
+					Message: &builderv1.ValidatorRegistration{
+						FeeRecipient: [20]byte{0x01},
+						GasLimit:     30000000,
+						Timestamp:    time.Unix(1700000000, 0),
+						Pubkey:       phase0.BLSPubKey{0x02},
+					},
+					Signature: phase0.BLSSignature{0x03},
+				},
+			},
+		},
+		delivered: []*v1.BidTrace{
+			{
+				Slot:      100,
+				BlockHash: phase0.Hash32{0x04},
+				GasLimit:  30000000,
+				GasUsed:   15000000,
+				Value:     big.NewInt(12345),
+			},
+		},
+		received: []*v1.BidTraceWithTimestamp{
+			{
+				Slot:      100,
+				BlockHash: phase0.Hash32{0x04},
+				Value:     big.NewInt(12345),
+				Timestamp: time.UnixMilli(1700000000123),
+			},
+			{
+				Slot:      100,
+				BlockHash: phase0.Hash32{0x05},
+				Value:     big.NewInt(123),
+				Timestamp: time.UnixMilli(1700000000456),
+			},
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []server.Parameter
+		err    string
+	}{
+		{
+			name: "ListenAddressMissing",
+			params: []server.Parameter{
+				server.WithStore(testStore()),
+			},
+			err: "problem with parameters: no listen address specified",
+		},
+		{
+			name: "StoreMissing",
+			params: []server.Parameter{
+				server.WithListenAddress("127.0.0.1:0"),
+			},
+			err: "problem with parameters: no store specified",
+		},
+		{
+			name: "TimeoutZero",
+			params: []server.Parameter{
+				server.WithListenAddress("127.0.0.1:0"),
+				server.WithStore(testStore()),
+				server.WithTimeout(0),
+			},
+			err: "problem with parameters: timeout must be positive",
+		},
+		{
+			name: "ListenAddressInvalid",
+			params: []server.Parameter{
+				server.WithListenAddress("invalid"),
+				server.WithStore(testStore()),
+			},
+			err: "failed to listen: listen tcp: address invalid: missing port in address",
+		},
+		{
+			name: "Good",
+			params: []server.Parameter{
+				server.WithListenAddress("127.0.0.1:0"),
+				server.WithStore(testStore()),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := server.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestClient ensures that the server is wire-compatible with the client.
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+
+	data := testStore()
+	s, err := server.New(ctx,
+		server.WithListenAddress("127.0.0.1:0"),
+		server.WithStore(data),
+	)
+	require.NoError(t, err)
+
+	relay, err := http.New(ctx,
+		http.WithAddress(s.Address()),
+	)
+	require.NoError(t, err)
+
+	proposers, err := relay.(client.QueuedProposersProvider).QueuedProposers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, data.proposers, proposers)
+
+	delivered, err := relay.(client.DeliveredBidTraceProvider).DeliveredBidTrace(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, data.delivered[0], delivered)
+	require.Equal(t, phase0.Slot(100), *data.deliveredFilter.Slot)
+
+	delivered, err = relay.(client.DeliveredBidTraceProvider).DeliveredBidTrace(ctx, 101)
+	require.NoError(t, err)
+	require.Nil(t, delivered)
+
+	received, err := relay.(client.ReceivedBidTracesProvider).ReceivedBidTraces(ctx, 100)
+	require.NoError(t, err)
+	require.Len(t, received, 2)
+	require.Equal(t, data.received[1].BlockHash, received[1].BlockHash)
+	require.Equal(t, data.received[1].Timestamp.UnixMilli(), received[1].Timestamp.UnixMilli())
+
+	data.err = errors.New("store down")
+	_, err = relay.(client.QueuedProposersProvider).QueuedProposers(ctx)
+	require.EqualError(t, err, `failed to request queued proposers: GET failed with status 500: {"code":500,"message":"failed to obtain queued proposers"}`)
+}
+
+func TestQueries(t *testing.T) {
+	ctx := context.Background()
+
+	data := testStore()
+	s, err := server.New(ctx,
+		server.WithListenAddress("127.0.0.1:0"),
+		server.WithStore(data),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		method     string
+		url        string
+		statusCode int
+		body       string
+	}{
+		{
+			name:       "MethodNotAllowed",
+			method:     nethttp.MethodPost,
+			url:        "/relay/v1/builder/validators",
+			statusCode: nethttp.StatusMethodNotAllowed,
+			body:       `{"code":405,"message":"method not allowed"}`,
+		},
+		{
+			name:       "SlotInvalid",
+			url:        "/relay/v1/data/bidtraces/proposer_payload_delivered?slot=abc",
+			statusCode: nethttp.StatusBadRequest,
+			body:       `{"code":400,"message":"invalid slot"}`,
+		},
+		{
+			name:       "SlotAndCursor",
+			url:        "/relay/v1/data/bidtraces/proposer_payload_delivered?slot=1&cursor=2",
+			statusCode: nethttp.StatusBadRequest,
+			body:       `{"code":400,"message":"cannot specify both slot and cursor"}`,
+		},
+		{
+			name:       "LimitTooHigh",
+			url:        "/relay/v1/data/bidtraces/proposer_payload_delivered?limit=201",
+			statusCode: nethttp.StatusBadRequest,
+			body:       `{"code":400,"message":"maximum limit is 200"}`,
+		},
+		{
+			name:       "BlockHashShort",
+			url:        "/relay/v1/data/bidtraces/proposer_payload_delivered?block_hash=0x0102",
+			statusCode: nethttp.StatusBadRequest,
+			body:       `{"code":400,"message":"invalid block_hash"}`,
+		},
+		{
+			name:       "BuilderPubkeyInvalid",
+			url:        "/relay/v1/data/bidtraces/proposer_payload_delivered?builder_pubkey=xyz",
+			statusCode: nethttp.StatusBadRequest,
+			body:       `{"code":400,"message":"invalid builder_pubkey"}`,
+		},
+		{
+			name:       "OrderByInvalid",
+			url:        "/relay/v1/data/bidtraces/proposer_payload_delivered?order_by=slot",
+			statusCode: nethttp.StatusBadRequest,
+			body:       `{"code":400,"message":"invalid order_by"}`,
+		},
+		{
+			name:       "ReceivedUnfiltered",
+			url:        "/relay/v1/data/bidtraces/builder_blocks_received?limit=10",
+			statusCode: nethttp.StatusBadRequest,
+			body:       `{"code":400,"message":"need to query for specific slot or block_hash or block_number or builder_pubkey"}`,
+		},
+		{
+			name:       "ReceivedLimitTooHigh",
+			url:        "/relay/v1/data/bidtraces/builder_blocks_received?slot=1&limit=501",
+			statusCode: nethttp.StatusBadRequest,
+			body:       `{"code":400,"message":"maximum limit is 500"}`,
+		},
+		{
+			name:       "DeliveredEmpty",
+			url:        "/relay/v1/data/bidtraces/proposer_payload_delivered?slot=1",
+			statusCode: nethttp.StatusOK,
+			body:       `[]`,
+		},
+		{
+			name:       "ReceivedLimited",
+			url:        "/relay/v1/data/bidtraces/builder_blocks_received?slot=100&limit=1",
+			statusCode: nethttp.StatusOK,
+			body:       `[{"slot":"100","parent_hash":"0x0000000000000000000000000000000000000000000000000000000000000000","block_hash":"0x0400000000000000000000000000000000000000000000000000000000000000","builder_pubkey":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","proposer_pubkey":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","proposer_fee_recipient":"0x0000000000000000000000000000000000000000","gas_limit":"0","gas_used":"0","value":"12345","timestamp":"1700000000","timestamp_ms":"1700000000123"}]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			method := test.method
+			if method == "" {
+				method = nethttp.MethodGet
+			}
+			req := httptest.NewRequest(method, test.url, nil)
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, req)
+			require.Equal(t, test.statusCode, rec.Code)
+			body, err := io.ReadAll(rec.Body)
+			require.NoError(t, err)
+			require.Equal(t, test.body, string(body))
+			require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestDeliveredFilter(t *testing.T) {
+	ctx := context.Background()
+
+	data := testStore()
+	s, err := server.New(ctx,
+		server.WithListenAddress("127.0.0.1:0"),
+		server.WithStore(data),
+	)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(nethttp.MethodGet, "/relay/v1/data/bidtraces/proposer_payload_delivered?cursor=200&limit=50&order_by=-value&block_number=17&proposer_pubkey=0x"+
+		"020000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", nil)
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	require.Equal(t, nethttp.StatusOK, rec.Code)
+
+	filter := data.deliveredFilter
+	require.Nil(t, filter.Slot)
+	require.Equal(t, phase0.Slot(200), *filter.Cursor)
+	require.Equal(t, uint64(50), filter.Limit)
+	require.Equal(t, server.OrderByValueDescending, filter.OrderBy)
+	require.Equal(t, "-value", filter.OrderBy.String())
+	require.Equal(t, uint64(17), *filter.BlockNumber)
+	require.Equal(t, phase0.BLSPubKey{0x02}, *filter.ProposerPubkey)
+	require.Nil(t, filter.BuilderPubkey)
+	require.Nil(t, filter.BlockHash)
+}
@@ -0,0 +1,81 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// Sourced is a record annotated with the relays from which it was obtained.
+// It marshals as the record's JSON object with an additional "relays" field.
+// As this is not part of the relay data API, the server only returns sourced
+// records when the request sets include_relays=true.
+type Sourced[T any] struct {
+	Record T
+	Relays []string
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *Sourced[T]) MarshalJSON() ([]byte, error) {
+	record, err := json.Marshal(s.Record)
+	if err != nil {
+		return nil, err
+	}
+	record = bytes.TrimSpace(record)
+	if len(record) < 2 || record[0] != '{' || record[len(record)-1] != '}' {
+		return nil, errors.New("record is not a JSON object")
+	}
+
+	relays := s.Relays
+	if relays == nil {
+		relays = make([]string, 0)
+	}
+	relaysJSON, err := json.Marshal(relays)
+	if err != nil {
+		return nil, err
+	}
+
+	res := bytes.NewBuffer(make([]byte, 0, len(record)+len(relaysJSON)+12))
+	res.Write(record[:len(record)-1])
+	if len(record) > 2 {
+		res.WriteByte(',')
+	}
+	res.WriteString(`"relays":`)
+	res.Write(relaysJSON)
+	res.WriteByte('}')
+
+	return res.Bytes(), nil
+}
+
+// SourcedStore is an optional interface for stores that can annotate
+// records with the relays from which they were obtained.  If a store
+// implements it the server uses it in preference to Store for requests
+// that set include_relays=true.
+type SourcedStore interface {
+	Store
+
+	// SourcedQueuedProposers provides the proposers queued for the current and next epoch.
+	SourcedQueuedProposers(ctx context.Context) ([]*Sourced[*v1.QueuedProposer], error)
+
+	// SourcedDeliveredBidTraces provides the bid traces of delivered payloads that match the filter.
+	SourcedDeliveredBidTraces(ctx context.Context, filter *DeliveredBidTracesFilter) ([]*Sourced[*v1.BidTrace], error)
+
+	// SourcedReceivedBidTraces provides the received bid traces that match the filter.
+	SourcedReceivedBidTraces(ctx context.Context, filter *ReceivedBidTracesFilter) ([]*Sourced[*v1.BidTraceWithTimestamp], error)
+}
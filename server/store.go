@@ -0,0 +1,92 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// ErrUnsupportedQuery is returned, possibly wrapped, by stores that cannot
+// answer a query.  The server reports it to the caller as a bad request.
+var ErrUnsupportedQuery = errors.New("unsupported query")
+
+// OrderBy is the order in which delivered bid traces are returned.
+type OrderBy int
+
+const (
+	// OrderBySlotDescending orders by slot, latest first.
+	OrderBySlotDescending OrderBy = iota
+	// OrderByValueAscending orders by value, lowest first.
+	OrderByValueAscending
+	// OrderByValueDescending orders by value, highest first.
+	OrderByValueDescending
+)
+
+var orderByStrings = [...]string{
+	"-slot",
+	"value",
+	"-value",
+}
+
+// String returns a string representation of the order.
+func (o OrderBy) String() string {
+	if o < 0 || int(o) >= len(orderByStrings) {
+		return "unknown"
+	}
+
+	return orderByStrings[o]
+}
+
+// DeliveredBidTracesFilter selects delivered bid traces.
+// Nil fields are not used to filter.
+type DeliveredBidTracesFilter struct {
+	Slot *phase0.Slot
+	// Cursor is the latest slot to return; it cannot be used with Slot.
+	Cursor         *phase0.Slot
+	BlockHash      *phase0.Hash32
+	BlockNumber    *uint64
+	ProposerPubkey *phase0.BLSPubKey
+	BuilderPubkey  *phase0.BLSPubKey
+	OrderBy        OrderBy
+	// Limit is the maximum number of traces to return.
+	Limit uint64
+}
+
+// ReceivedBidTracesFilter selects received bid traces.
+// Nil fields are not used to filter, but at least one field other than
+// Limit is always set.
+type ReceivedBidTracesFilter struct {
+	Slot          *phase0.Slot
+	BlockHash     *phase0.Hash32
+	BlockNumber   *uint64
+	BuilderPubkey *phase0.BLSPubKey
+	// Limit is the maximum number of traces to return.
+	Limit uint64
+}
+
+// Store is the interface for the data served by the server.
+type Store interface {
+	// QueuedProposers provides the proposers queued for the current and next epoch.
+	QueuedProposers(ctx context.Context) ([]*v1.QueuedProposer, error)
+
+	// DeliveredBidTraces provides the bid traces of delivered payloads that match the filter.
+	DeliveredBidTraces(ctx context.Context, filter *DeliveredBidTracesFilter) ([]*v1.BidTrace, error)
+
+	// ReceivedBidTraces provides the received bid traces that match the filter.
+	ReceivedBidTraces(ctx context.Context, filter *ReceivedBidTracesFilter) ([]*v1.BidTraceWithTimestamp, error)
+}
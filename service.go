@@ -15,8 +15,11 @@ package client
 
 import (
 	"context"
+	"net/url"
 
+	builderv1 "github.com/attestantio/go-builder-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-relay-client/api"
 	v1 "github.com/attestantio/go-relay-client/api/v1"
 )
 
@@ -40,6 +43,17 @@ type QueuedProposersProvider interface {
 	QueuedProposers(ctx context.Context) ([]*v1.QueuedProposer, error)
 }
 
+// QueuedProposersWithInvalidProvider is the interface for providing queued proposer information
+// along with proposers whose registrations failed verification.
+type QueuedProposersWithInvalidProvider interface {
+	Service
+
+	// QueuedProposersWithInvalid provides information on the proposers queued to obtain a blinded block.
+	// If registration verification is enabled proposers with invalid registrations are returned
+	// separately in the result.
+	QueuedProposersWithInvalid(ctx context.Context) (*api.QueuedProposersResult, error)
+}
+
 // DeliveredBidTraceProvider is the interface for providing bid traces for delivered payloads.
 type DeliveredBidTraceProvider interface {
 	Service
@@ -49,6 +63,15 @@ type DeliveredBidTraceProvider interface {
 	DeliveredBidTrace(ctx context.Context, slot phase0.Slot) (*v1.BidTrace, error)
 }
 
+// DeliveredBidTracesProvider is the interface for providing bid traces for delivered payloads for many slots.
+type DeliveredBidTracesProvider interface {
+	Service
+
+	// DeliveredBidTraces provides bid traces of delivered payloads for the given slots.
+	// The result contains an entry for each slot, with any error specific to that slot.
+	DeliveredBidTraces(ctx context.Context, slots []phase0.Slot) (map[phase0.Slot]*api.DeliveredBidTraceResult, error)
+}
+
 // ReceivedBidTracesProvider is the interface for obtaining bid traces received by a relay.
 type ReceivedBidTracesProvider interface {
 	Service
@@ -56,3 +79,50 @@ type ReceivedBidTracesProvider interface {
 	// ReceivedBidTraces provides all bid traces received for a given slot.
 	ReceivedBidTraces(ctx context.Context, slot phase0.Slot) ([]*v1.BidTraceWithTimestamp, error)
 }
+
+// DeliveredBidTracesQueryProvider is the interface for querying bid traces for delivered payloads.
+type DeliveredBidTracesQueryProvider interface {
+	Service
+
+	// QueryDeliveredBidTraces provides bid traces of delivered payloads that match the query,
+	// which uses the query parameters of the relay data API.
+	QueryDeliveredBidTraces(ctx context.Context, query url.Values) ([]*v1.BidTrace, error)
+}
+
+// ReceivedBidTracesQueryProvider is the interface for querying received bid traces.
+type ReceivedBidTracesQueryProvider interface {
+	Service
+
+	// QueryReceivedBidTraces provides received bid traces that match the query,
+	// which uses the query parameters of the relay data API.
+	QueryReceivedBidTraces(ctx context.Context, query url.Values) ([]*v1.BidTraceWithTimestamp, error)
+}
+
+// ReceivedBidTracesWithErrorsProvider is the interface for providing received bid traces
+// along with errors for individual records that could not be decoded.
+type ReceivedBidTracesWithErrorsProvider interface {
+	Service
+
+	// ReceivedBidTracesWithErrors provides all bid traces received for a given slot.
+	// If lenient decoding is enabled records that cannot be decoded are skipped, and
+	// returned as errors in the result.
+	ReceivedBidTracesWithErrors(ctx context.Context, slot phase0.Slot) (*api.ReceivedBidTracesResult, error)
+}
+
+// ValidatorRegistrationProvider is the interface for obtaining validator registrations held by a relay.
+type ValidatorRegistrationProvider interface {
+	Service
+
+	// ValidatorRegistration provides the latest registration held by the relay for a given validator.
+	// Will return nil if the relay does not hold a registration for the validator.
+	ValidatorRegistration(ctx context.Context, pubkey phase0.BLSPubKey) (*builderv1.SignedValidatorRegistration, error)
+}
+
+// TopBidStreamProvider is the interface for streaming updates to a relay's top bid.
+type TopBidStreamProvider interface {
+	Service
+
+	// TopBidStream streams updates to the relay's top bid.
+	// The channel is closed when the context is done.
+	TopBidStream(ctx context.Context) (<-chan *v1.TopBid, error)
+}
@@ -0,0 +1,178 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// Mismatch is a difference between the value claimed by a relay and the value on chain.
+type Mismatch struct {
+	// Field is the name of the field that differs.
+	Field string
+	// Claimed is the value claimed by the relay.
+	Claimed string
+	// Actual is the value found on chain.
+	Actual string
+}
+
+// String returns a string version of the structure.
+func (m *Mismatch) String() string {
+	return fmt.Sprintf("%s: claimed %s, actual %s", m.Field, m.Claimed, m.Actual)
+}
+
+// DeliveryResult is the result of verifying a delivered payload against the chain.
+type DeliveryResult struct {
+	// Trace is the bid trace that was verified.
+	Trace *v1.BidTrace
+	// Missed is true if there is no block on chain for the slot.
+	Missed bool
+	// Mismatches are the differences between the trace and the block on chain.
+	Mismatches []*Mismatch
+}
+
+// Verified returns true if the delivered payload is on chain as claimed.
+func (r *DeliveryResult) Verified() bool {
+	return !r.Missed && len(r.Mismatches) == 0
+}
+
+// VerifyDelivery verifies that the payload delivered by a relay is on chain
+// with the execution details claimed by the trace.
+func (s *Service) VerifyDelivery(ctx context.Context, trace *v1.BidTrace) (*DeliveryResult, error) {
+	if trace == nil {
+		return nil, errors.New("no trace supplied")
+	}
+
+	res := &DeliveryResult{
+		Trace:      trace,
+		Mismatches: make([]*Mismatch, 0),
+	}
+
+	payload, err := s.executionPayload(ctx, trace.Slot)
+	if err != nil {
+		return nil, err
+	}
+	if payload == nil {
+		log.Debug().Uint64("slot", uint64(trace.Slot)).Msg("No block on chain for slot")
+		res.Missed = true
+
+		return res, nil
+	}
+
+	blockHash, err := payload.BlockHash()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block hash")
+	}
+	if blockHash != trace.BlockHash {
+		res.Mismatches = append(res.Mismatches, &Mismatch{
+			Field:   "block hash",
+			Claimed: fmt.Sprintf("%#x", trace.BlockHash),
+			Actual:  fmt.Sprintf("%#x", blockHash),
+		})
+	}
+
+	parentHash, err := payload.ParentHash()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain parent hash")
+	}
+	if parentHash != trace.ParentHash {
+		res.Mismatches = append(res.Mismatches, &Mismatch{
+			Field:   "parent hash",
+			Claimed: fmt.Sprintf("%#x", trace.ParentHash),
+			Actual:  fmt.Sprintf("%#x", parentHash),
+		})
+	}
+
+	feeRecipient, err := payload.FeeRecipient()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain fee recipient")
+	}
+	if feeRecipient != trace.ProposerFeeRecipient {
+		res.Mismatches = append(res.Mismatches, &Mismatch{
+			Field:   "fee recipient",
+			Claimed: fmt.Sprintf("%#x", trace.ProposerFeeRecipient),
+			Actual:  fmt.Sprintf("%#x", feeRecipient),
+		})
+	}
+
+	gasLimit, err := payload.GasLimit()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain gas limit")
+	}
+	if gasLimit != trace.GasLimit {
+		res.Mismatches = append(res.Mismatches, &Mismatch{
+			Field:   "gas limit",
+			Claimed: fmt.Sprintf("%d", trace.GasLimit),
+			Actual:  fmt.Sprintf("%d", gasLimit),
+		})
+	}
+
+	gasUsed, err := payload.GasUsed()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain gas used")
+	}
+	if gasUsed != trace.GasUsed {
+		res.Mismatches = append(res.Mismatches, &Mismatch{
+			Field:   "gas used",
+			Claimed: fmt.Sprintf("%d", trace.GasUsed),
+			Actual:  fmt.Sprintf("%d", gasUsed),
+		})
+	}
+
+	return res, nil
+}
+
+// executionPayload obtains the execution payload of the block at the given slot.
+// Will return nil if there is no block at the slot.
+func (s *Service) executionPayload(ctx context.Context, slot phase0.Slot) (*spec.VersionedExecutionPayload, error) {
+	blockResponse, err := s.signedBeaconBlockProvider.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{
+		Block: fmt.Sprintf("%d", slot),
+	})
+	if err != nil {
+		var apiErr *api.Error
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+
+		return nil, errors.Wrap(err, "failed to obtain signed beacon block")
+	}
+	if blockResponse == nil || blockResponse.Data == nil {
+		return nil, nil
+	}
+	block := blockResponse.Data
+
+	blockSlot, err := block.Slot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block slot")
+	}
+	if blockSlot != slot {
+		// The block is not for the requested slot, so the slot is empty.
+		return nil, nil
+	}
+
+	payload, err := block.ExecutionPayload()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain execution payload")
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,196 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/verify"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// blockProvider is a mock signed beacon block provider.
+type blockProvider struct {
+	blocks map[phase0.Slot]*spec.VersionedSignedBeaconBlock
+	err    error
+}
+
+func (p *blockProvider) SignedBeaconBlock(_ context.Context,
+	opts *api.SignedBeaconBlockOpts,
+) (
+	*api.Response[*spec.VersionedSignedBeaconBlock],
+	error,
+) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	slot, err := strconv.ParseUint(opts.Block, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	block, exists := p.blocks[phase0.Slot(slot)]
+	if !exists {
+		return nil, &api.Error{
+			Method:     http.MethodGet,
+			StatusCode: http.StatusNotFound,
+			Endpoint:   "/eth/v2/beacon/blocks/" + opts.Block,
+		}
+	}
+
+	return &api.Response[*spec.VersionedSignedBeaconBlock]{Data: block}, nil
+}
+
+func denebBlock(slot phase0.Slot, payload *deneb.ExecutionPayload) *spec.VersionedSignedBeaconBlock {
+	return &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionDeneb,
+		Deneb: &deneb.SignedBeaconBlock{
+			Message: &deneb.BeaconBlock{
+				Slot: slot,
+				Body: &deneb.BeaconBlockBody{
+					ExecutionPayload: payload,
+				},
+			},
+		},
+	}
+}
+
+func testTrace(slot phase0.Slot) *v1.BidTrace {
+	return &v1.BidTrace{
+		Slot:                 slot,
+		ParentHash:           phase0.Hash32{0x01},
+		BlockHash:            phase0.Hash32{0x02},
+		BuilderPubkey:        phase0.BLSPubKey{0x03},
+		ProposerPubkey:       phase0.BLSPubKey{0x04},
+		ProposerFeeRecipient: bellatrix.ExecutionAddress{0x05},
+		GasLimit:             30000000,
+		GasUsed:              12000000,
+		Value:                big.NewInt(1000000000000000000),
+	}
+}
+
+func testPayload() *deneb.ExecutionPayload {
+	return &deneb.ExecutionPayload{
+		ParentHash:   phase0.Hash32{0x01},
+		BlockHash:    phase0.Hash32{0x02},
+		FeeRecipient: bellatrix.ExecutionAddress{0x05},
+		BlockNumber:  100,
+		GasLimit:     30000000,
+		GasUsed:      12000000,
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := verify.New(context.Background())
+	require.EqualError(t, err, "problem with parameters: no signed beacon block provider specified")
+
+	_, err = verify.New(context.Background(), verify.WithSignedBeaconBlockProvider(&blockProvider{}))
+	require.NoError(t, err)
+}
+
+func TestVerifyDelivery(t *testing.T) {
+	mismatchedPayload := testPayload()
+	mismatchedPayload.BlockHash = phase0.Hash32{0x12}
+	mismatchedPayload.FeeRecipient = bellatrix.ExecutionAddress{0x15}
+	mismatchedPayload.GasUsed = 11000000
+
+	tests := []struct {
+		name       string
+		provider   *blockProvider
+		trace      *v1.BidTrace
+		missed     bool
+		mismatches []string
+		err        string
+	}{
+		{
+			name:     "TraceMissing",
+			provider: &blockProvider{},
+			err:      "no trace supplied",
+		},
+		{
+			name:     "ProviderError",
+			provider: &blockProvider{err: errors.New("mock error")},
+			trace:    testTrace(1),
+			err:      "failed to obtain signed beacon block: mock error",
+		},
+		{
+			name:     "Missed",
+			provider: &blockProvider{blocks: map[phase0.Slot]*spec.VersionedSignedBeaconBlock{}},
+			trace:    testTrace(1),
+			missed:   true,
+		},
+		{
+			name: "WrongSlot",
+			provider: &blockProvider{blocks: map[phase0.Slot]*spec.VersionedSignedBeaconBlock{
+				1: denebBlock(0, testPayload()),
+			}},
+			trace:  testTrace(1),
+			missed: true,
+		},
+		{
+			name: "Mismatches",
+			provider: &blockProvider{blocks: map[phase0.Slot]*spec.VersionedSignedBeaconBlock{
+				1: denebBlock(1, mismatchedPayload),
+			}},
+			trace: testTrace(1),
+			mismatches: []string{
+				"block hash: claimed 0x0200000000000000000000000000000000000000000000000000000000000000, actual 0x1200000000000000000000000000000000000000000000000000000000000000",
+				"fee recipient: claimed 0x0500000000000000000000000000000000000000, actual 0x1500000000000000000000000000000000000000",
+				"gas used: claimed 12000000, actual 11000000",
+			},
+		},
+		{
+			name: "Good",
+			provider: &blockProvider{blocks: map[phase0.Slot]*spec.VersionedSignedBeaconBlock{
+				1: denebBlock(1, testPayload()),
+			}},
+			trace: testTrace(1),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := verify.New(context.Background(), verify.WithSignedBeaconBlockProvider(test.provider))
+			require.NoError(t, err)
+
+			res, err := s.VerifyDelivery(context.Background(), test.trace)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.missed, res.Missed)
+			mismatches := make([]string, 0, len(res.Mismatches))
+			for _, mismatch := range res.Mismatches {
+				mismatches = append(mismatches, mismatch.String())
+			}
+			if len(test.mismatches) == 0 {
+				require.Empty(t, mismatches)
+			} else {
+				require.Equal(t, test.mismatches, mismatches)
+			}
+			require.Equal(t, !test.missed && len(test.mismatches) == 0, res.Verified())
+		})
+	}
+}
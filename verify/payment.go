@@ -0,0 +1,224 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// Transaction is the subset of an execution layer transaction required to verify payments.
+type Transaction struct {
+	From bellatrix.ExecutionAddress
+	// To is nil for contract creation transactions.
+	To    *bellatrix.ExecutionAddress
+	Value *big.Int
+}
+
+// ExecutionProvider provides execution layer information required to verify proposer payments.
+type ExecutionProvider interface {
+	// TransactionInBlock provides the transaction at the given index in the block with the given hash.
+	// If there is no such transaction it returns nil for both the transaction and the error.
+	TransactionInBlock(ctx context.Context, blockHash phase0.Hash32, index uint64) (*Transaction, error)
+
+	// BalanceAt provides the balance of the address at the end of the given block.
+	BalanceAt(ctx context.Context, address bellatrix.ExecutionAddress, blockNumber uint64) (*big.Int, error)
+}
+
+// PaymentMethod is the method by which a proposer was paid.
+type PaymentMethod int
+
+const (
+	// PaymentMethodUnknown means no payment to the proposer was found.
+	PaymentMethodUnknown PaymentMethod = iota
+	// PaymentMethodLastTransaction means the proposer was paid by the last transaction in the block.
+	PaymentMethodLastTransaction
+	// PaymentMethodCoinbase means the proposer's fee recipient was the block's fee recipient.
+	PaymentMethodCoinbase
+)
+
+var paymentMethodStrings = [...]string{
+	"unknown",
+	"last transaction",
+	"coinbase",
+}
+
+// String returns a string representation of the payment method.
+func (m PaymentMethod) String() string {
+	if int(m) >= len(paymentMethodStrings) {
+		return paymentMethodStrings[0]
+	}
+
+	return paymentMethodStrings[m]
+}
+
+// PaymentResult is the result of verifying a proposer payment.
+type PaymentResult struct {
+	// Trace is the bid trace that was verified.
+	Trace *v1.BidTrace
+	// Missed is true if there is no block on chain for the slot.
+	Missed bool
+	// WrongBlock is true if the block on chain for the slot is not the block
+	// in the trace, for example if the proposer built a block locally.  No
+	// payment is calculated in this situation.
+	WrongBlock bool
+	// Method is the method by which the proposer was paid.
+	Method PaymentMethod
+	// Paid is the amount paid to the proposer's fee recipient.
+	Paid *big.Int
+}
+
+// Shortfall returns the amount by which the payment was less than the value
+// claimed by the relay.  Returns zero if the proposer was paid in full.
+func (r *PaymentResult) Shortfall() *big.Int {
+	shortfall := new(big.Int).Sub(r.Trace.Value, r.Paid)
+	if shortfall.Sign() < 0 {
+		return new(big.Int)
+	}
+
+	return shortfall
+}
+
+// Verified returns true if the proposer was paid at least the claimed value.
+func (r *PaymentResult) Verified() bool {
+	return !r.Missed && !r.WrongBlock && r.Paid.Cmp(r.Trace.Value) >= 0
+}
+
+// VerifyPayment verifies that the proposer was paid the value claimed by the
+// trace to the proposer's fee recipient.  Payments are only considered if the
+// block on chain is the block in the trace.
+func (s *Service) VerifyPayment(ctx context.Context, trace *v1.BidTrace) (*PaymentResult, error) {
+	if s.executionProvider == nil {
+		return nil, errors.New("no execution provider configured")
+	}
+	if trace == nil {
+		return nil, errors.New("no trace supplied")
+	}
+	if trace.Value == nil {
+		return nil, errors.New("trace has no value")
+	}
+
+	res := &PaymentResult{
+		Trace:  trace,
+		Method: PaymentMethodUnknown,
+		Paid:   new(big.Int),
+	}
+
+	payload, err := s.executionPayload(ctx, trace.Slot)
+	if err != nil {
+		return nil, err
+	}
+	if payload == nil {
+		res.Missed = true
+
+		return res, nil
+	}
+
+	blockHash, err := payload.BlockHash()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block hash")
+	}
+	if blockHash != trace.BlockHash {
+		// Any payment in this block was not made for this trace.
+		res.WrongBlock = true
+
+		return res, nil
+	}
+	feeRecipient, err := payload.FeeRecipient()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain fee recipient")
+	}
+	blockNumber, err := payload.BlockNumber()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block number")
+	}
+
+	if feeRecipient == trace.ProposerFeeRecipient {
+		res.Method = PaymentMethodCoinbase
+		res.Paid, err = s.coinbasePayment(ctx, payload, feeRecipient, blockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		return res, nil
+	}
+
+	transactions, err := payload.Transactions()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain transactions")
+	}
+	if len(transactions) == 0 {
+		return res, nil
+	}
+
+	tx, err := s.executionProvider.TransactionInBlock(ctx, blockHash, uint64(len(transactions)-1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain last transaction")
+	}
+	if tx != nil &&
+		tx.From == feeRecipient &&
+		tx.To != nil &&
+		*tx.To == trace.ProposerFeeRecipient &&
+		tx.Value != nil {
+		res.Method = PaymentMethodLastTransaction
+		res.Paid = tx.Value
+	}
+
+	return res, nil
+}
+
+// coinbasePayment calculates the payment to a fee recipient that is also the
+// block's fee recipient, from its change in balance over the block.
+func (s *Service) coinbasePayment(ctx context.Context,
+	payload *spec.VersionedExecutionPayload,
+	feeRecipient bellatrix.ExecutionAddress,
+	blockNumber uint64,
+) (
+	*big.Int,
+	error,
+) {
+	if blockNumber == 0 {
+		return nil, errors.New("cannot calculate payment for genesis block")
+	}
+
+	before, err := s.executionProvider.BalanceAt(ctx, feeRecipient, blockNumber-1)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain balance before block")
+	}
+	after, err := s.executionProvider.BalanceAt(ctx, feeRecipient, blockNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain balance after block")
+	}
+	paid := new(big.Int).Sub(after, before)
+
+	// Withdrawals to the fee recipient also increase its balance, so remove them.
+	// Pre-capella payloads have no withdrawals, so an error here is ignored.
+	withdrawals, _ := payload.Withdrawals()
+	for _, withdrawal := range withdrawals {
+		if withdrawal.Address == feeRecipient {
+			paid.Sub(paid, new(big.Int).Mul(new(big.Int).SetUint64(uint64(withdrawal.Amount)), big.NewInt(1e9)))
+		}
+	}
+	if paid.Sign() < 0 {
+		paid.SetInt64(0)
+	}
+
+	return paid, nil
+}
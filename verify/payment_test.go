@@ -0,0 +1,232 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-relay-client/verify"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// executionProvider is a mock execution provider.
+type executionProvider struct {
+	lastTx   *verify.Transaction
+	noTx     bool
+	balances map[uint64]*big.Int
+}
+
+func (p *executionProvider) TransactionInBlock(_ context.Context, _ phase0.Hash32, _ uint64) (*verify.Transaction, error) {
+	if p.noTx {
+		return nil, nil
+	}
+	if p.lastTx == nil {
+		return nil, errors.New("transaction not found")
+	}
+
+	return p.lastTx, nil
+}
+
+func (p *executionProvider) BalanceAt(_ context.Context, _ bellatrix.ExecutionAddress, blockNumber uint64) (*big.Int, error) {
+	balance, exists := p.balances[blockNumber]
+	if !exists {
+		return nil, errors.New("balance not found")
+	}
+
+	return balance, nil
+}
+
+func TestVerifyPayment(t *testing.T) {
+	builderCoinbase := bellatrix.ExecutionAddress{0x0b}
+	proposerFeeRecipient := bellatrix.ExecutionAddress{0x05}
+	otherAddress := bellatrix.ExecutionAddress{0x0c}
+
+	builderPayload := testPayload()
+	builderPayload.FeeRecipient = builderCoinbase
+	builderPayload.Transactions = []bellatrix.Transaction{{0x01}, {0x02}}
+
+	wrongBuilderPayload := testPayload()
+	wrongBuilderPayload.BlockHash = phase0.Hash32{0x12}
+	wrongBuilderPayload.FeeRecipient = builderCoinbase
+	wrongBuilderPayload.Transactions = []bellatrix.Transaction{{0x01}, {0x02}}
+
+	coinbasePayload := testPayload()
+
+	// A block built locally by the proposer pays its fee recipient directly.
+	localPayload := testPayload()
+	localPayload.BlockHash = phase0.Hash32{0x12}
+
+	coinbaseWithdrawalPayload := testPayload()
+	coinbaseWithdrawalPayload.Withdrawals = []*capella.Withdrawal{
+		{Address: proposerFeeRecipient, Amount: 10000000},
+		{Address: otherAddress, Amount: 20000000},
+	}
+
+	tests := []struct {
+		name      string
+		blocks    map[phase0.Slot]*spec.VersionedSignedBeaconBlock
+		execution *executionProvider
+		missed    bool
+		wrong     bool
+		method    verify.PaymentMethod
+		paid      string
+		verified  bool
+		err       string
+	}{
+		{
+			name:      "Missed",
+			blocks:    map[phase0.Slot]*spec.VersionedSignedBeaconBlock{},
+			execution: &executionProvider{},
+			missed:    true,
+			paid:      "0",
+		},
+		{
+			name:   "LastTransaction",
+			blocks: map[phase0.Slot]*spec.VersionedSignedBeaconBlock{1: denebBlock(1, builderPayload)},
+			execution: &executionProvider{
+				lastTx: &verify.Transaction{From: builderCoinbase, To: &proposerFeeRecipient, Value: big.NewInt(1000000000000000000)},
+			},
+			method:   verify.PaymentMethodLastTransaction,
+			paid:     "1000000000000000000",
+			verified: true,
+		},
+		{
+			name:   "LastTransactionUnderpaid",
+			blocks: map[phase0.Slot]*spec.VersionedSignedBeaconBlock{1: denebBlock(1, builderPayload)},
+			execution: &executionProvider{
+				lastTx: &verify.Transaction{From: builderCoinbase, To: &proposerFeeRecipient, Value: big.NewInt(900000000000000000)},
+			},
+			method: verify.PaymentMethodLastTransaction,
+			paid:   "900000000000000000",
+		},
+		{
+			name:   "LastTransactionWrongRecipient",
+			blocks: map[phase0.Slot]*spec.VersionedSignedBeaconBlock{1: denebBlock(1, builderPayload)},
+			execution: &executionProvider{
+				lastTx: &verify.Transaction{From: builderCoinbase, To: &otherAddress, Value: big.NewInt(1000000000000000000)},
+			},
+			method: verify.PaymentMethodUnknown,
+			paid:   "0",
+		},
+		{
+			name:      "LastTransactionMissing",
+			blocks:    map[phase0.Slot]*spec.VersionedSignedBeaconBlock{1: denebBlock(1, builderPayload)},
+			execution: &executionProvider{noTx: true},
+			method:    verify.PaymentMethodUnknown,
+			paid:      "0",
+		},
+		{
+			name:   "LastTransactionWrongBlock",
+			blocks: map[phase0.Slot]*spec.VersionedSignedBeaconBlock{1: denebBlock(1, wrongBuilderPayload)},
+			execution: &executionProvider{
+				lastTx: &verify.Transaction{From: builderCoinbase, To: &proposerFeeRecipient, Value: big.NewInt(1000000000000000000)},
+			},
+			wrong:  true,
+			method: verify.PaymentMethodUnknown,
+			paid:   "0",
+		},
+		{
+			name:      "LastTransactionError",
+			blocks:    map[phase0.Slot]*spec.VersionedSignedBeaconBlock{1: denebBlock(1, builderPayload)},
+			execution: &executionProvider{},
+			err:       "failed to obtain last transaction: transaction not found",
+		},
+		{
+			name:   "Coinbase",
+			blocks: map[phase0.Slot]*spec.VersionedSignedBeaconBlock{1: denebBlock(1, coinbasePayload)},
+			execution: &executionProvider{
+				balances: map[uint64]*big.Int{
+					99:  big.NewInt(5000000000000000000),
+					100: big.NewInt(6000000000000000000),
+				},
+			},
+			method:   verify.PaymentMethodCoinbase,
+			paid:     "1000000000000000000",
+			verified: true,
+		},
+		{
+			name:   "CoinbaseWithWithdrawal",
+			blocks: map[phase0.Slot]*spec.VersionedSignedBeaconBlock{1: denebBlock(1, coinbaseWithdrawalPayload)},
+			execution: &executionProvider{
+				balances: map[uint64]*big.Int{
+					99:  big.NewInt(5000000000000000000),
+					100: big.NewInt(6000000000000000000),
+				},
+			},
+			method: verify.PaymentMethodCoinbase,
+			paid:   "990000000000000000",
+		},
+		{
+			name:   "CoinbaseWrongBlock",
+			blocks: map[phase0.Slot]*spec.VersionedSignedBeaconBlock{1: denebBlock(1, localPayload)},
+			execution: &executionProvider{
+				balances: map[uint64]*big.Int{
+					99:  big.NewInt(5000000000000000000),
+					100: big.NewInt(6000000000000000000),
+				},
+			},
+			wrong:  true,
+			method: verify.PaymentMethodUnknown,
+			paid:   "0",
+		},
+		{
+			name:   "CoinbaseBalanceError",
+			blocks: map[phase0.Slot]*spec.VersionedSignedBeaconBlock{1: denebBlock(1, coinbasePayload)},
+			execution: &executionProvider{
+				balances: map[uint64]*big.Int{},
+			},
+			err: "failed to obtain balance before block: balance not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := verify.New(context.Background(),
+				verify.WithSignedBeaconBlockProvider(&blockProvider{blocks: test.blocks}),
+				verify.WithExecutionProvider(test.execution),
+			)
+			require.NoError(t, err)
+
+			res, err := s.VerifyPayment(context.Background(), testTrace(1))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.missed, res.Missed)
+			require.Equal(t, test.wrong, res.WrongBlock)
+			require.Equal(t, test.method, res.Method)
+			require.Equal(t, test.paid, res.Paid.String())
+			require.Equal(t, test.verified, res.Verified())
+			if test.verified {
+				require.Zero(t, res.Shortfall().Sign())
+			}
+		})
+	}
+}
+
+func TestVerifyPaymentNoExecutionProvider(t *testing.T) {
+	s, err := verify.New(context.Background(), verify.WithSignedBeaconBlockProvider(&blockProvider{}))
+	require.NoError(t, err)
+
+	_, err = s.VerifyPayment(context.Background(), testTrace(1))
+	require.EqualError(t, err, "no execution provider configured")
+}
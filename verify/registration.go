@@ -0,0 +1,155 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	builderv1 "github.com/attestantio/go-builder-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-relay-client/api"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// builderDomainType is the domain type for builder API signatures, DOMAIN_APPLICATION_BUILDER.
+var builderDomainType = phase0.DomainType{0x00, 0x00, 0x00, 0x01}
+
+// signatureDST is the domain separation tag for Ethereum BLS signatures.
+var signatureDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// BuilderDomain computes the builder domain for the chain with the given
+// genesis fork version.  Builder signatures always use an empty genesis
+// validators root, as per the builder specification.
+func BuilderDomain(genesisForkVersion phase0.Version) (phase0.Domain, error) {
+	forkData := &phase0.ForkData{
+		CurrentVersion:        genesisForkVersion,
+		GenesisValidatorsRoot: phase0.Root{},
+	}
+	root, err := forkData.HashTreeRoot()
+	if err != nil {
+		return phase0.Domain{}, errors.Wrap(err, "failed to calculate fork data root")
+	}
+
+	var domain phase0.Domain
+	copy(domain[:], builderDomainType[:])
+	copy(domain[4:], root[:])
+
+	return domain, nil
+}
+
+// RegistrationSigningRoot computes the signing root of a validator
+// registration for the chain with the given genesis fork version.
+func RegistrationSigningRoot(registration *builderv1.ValidatorRegistration,
+	genesisForkVersion phase0.Version,
+) (
+	phase0.Root,
+	error,
+) {
+	if registration == nil {
+		return phase0.Root{}, errors.New("no registration supplied")
+	}
+
+	domain, err := BuilderDomain(genesisForkVersion)
+	if err != nil {
+		return phase0.Root{}, err
+	}
+	objectRoot, err := registration.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate registration root")
+	}
+	signingData := &phase0.SigningData{
+		ObjectRoot: objectRoot,
+		Domain:     domain,
+	}
+	signingRoot, err := signingData.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate signing root")
+	}
+
+	return signingRoot, nil
+}
+
+// VerifyRegistration verifies the signature of a signed validator
+// registration against its public key, for the chain with the given genesis
+// fork version.  Returns an error if the signature is not valid.
+func VerifyRegistration(registration *builderv1.SignedValidatorRegistration,
+	genesisForkVersion phase0.Version,
+) error {
+	if registration == nil {
+		return errors.New("no signed registration supplied")
+	}
+	if registration.Message == nil {
+		return errors.New("registration message missing")
+	}
+
+	signingRoot, err := RegistrationSigningRoot(registration.Message, genesisForkVersion)
+	if err != nil {
+		return err
+	}
+
+	pubkey := new(blst.P1Affine).Uncompress(registration.Message.Pubkey[:])
+	if pubkey == nil {
+		return errors.New("invalid public key")
+	}
+	signature := new(blst.P2Affine).Uncompress(registration.Signature[:])
+	if signature == nil {
+		return errors.New("invalid signature")
+	}
+	if !signature.Verify(true, pubkey, true, signingRoot[:], signatureDST) {
+		return errors.New("signature does not verify")
+	}
+
+	return nil
+}
+
+// VerifyQueuedProposers verifies the registration signatures of queued
+// proposers for the chain with the given genesis fork version.  It returns
+// the proposers with valid registrations and details of those without.
+func VerifyQueuedProposers(proposers []*v1.QueuedProposer,
+	genesisForkVersion phase0.Version,
+) (
+	[]*v1.QueuedProposer,
+	[]*api.InvalidRegistration,
+) {
+	valid := make([]*v1.QueuedProposer, 0, len(proposers))
+	invalid := make([]*api.InvalidRegistration, 0)
+	for _, proposer := range proposers {
+		if proposer == nil {
+			continue
+		}
+		if err := VerifyRegistration(proposer.Entry, genesisForkVersion); err != nil {
+			invalid = append(invalid, &api.InvalidRegistration{
+				Proposer: proposer,
+				Err:      err,
+			})
+
+			continue
+		}
+		valid = append(valid, proposer)
+	}
+
+	return valid, invalid
+}
+
+// QueuedProposersVerifier returns a function that verifies the registration
+// signatures of queued proposers for the chain with the given genesis fork
+// version, suitable for use as an HTTP client registration verifier.
+func QueuedProposersVerifier(genesisForkVersion phase0.Version) func([]*v1.QueuedProposer) (
+	[]*v1.QueuedProposer,
+	[]*api.InvalidRegistration,
+) {
+	return func(proposers []*v1.QueuedProposer) ([]*v1.QueuedProposer, []*api.InvalidRegistration) {
+		return VerifyQueuedProposers(proposers, genesisForkVersion)
+	}
+}
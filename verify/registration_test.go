@@ -0,0 +1,121 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	builderv1 "github.com/attestantio/go-builder-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/verify"
+	"github.com/stretchr/testify/require"
+)
+
+// goerliRegistration is a registration signed for the Goerli testnet.
+var goerliRegistration = []byte(`{"message":{"fee_recipient":"0x388Ea662EF2c223eC0B047D41Bf3c0f362142ad5","gas_limit":"30000000","timestamp":"1663144444","pubkey":"0xa35e34e6aff03a0e37e0aeeeb2629ba3b503b285ddc75ff2ef8dc854653d833af289f0458cd614e3906ec5e9627b31db"},"signature":"0xb735529068b64c24c7650b08ddb09d543b79030888801176d2708f0e0c863a965fc1ba03f8fb14e5b3b486386e1f147b13848c218e143b513886a0f210c096bd03077fcac658c39402f2ca9075422a6df6b54f17f4141334239f9f9ff8137be0"}`)
+
+func registration(t *testing.T) *builderv1.SignedValidatorRegistration {
+	t.Helper()
+	var res builderv1.SignedValidatorRegistration
+	require.NoError(t, json.Unmarshal(goerliRegistration, &res))
+
+	return &res
+}
+
+func TestBuilderDomain(t *testing.T) {
+	domain, err := verify.BuilderDomain(phase0.Version{0x00, 0x00, 0x00, 0x00})
+	require.NoError(t, err)
+	require.Equal(t, "0x00000001f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9", fmt.Sprintf("%#x", domain))
+}
+
+func TestVerifyRegistration(t *testing.T) {
+	wrongGasLimit := registration(t)
+	wrongGasLimit.Message.GasLimit = 30000001
+
+	badSignature := registration(t)
+	badSignature.Signature = phase0.BLSSignature{0x01}
+
+	goerliForkVersion := phase0.Version{0x00, 0x00, 0x10, 0x20}
+
+	tests := []struct {
+		name         string
+		registration *builderv1.SignedValidatorRegistration
+		forkVersion  phase0.Version
+		err          string
+	}{
+		{
+			name: "Nil",
+			err:  "no signed registration supplied",
+		},
+		{
+			name:         "MessageMissing",
+			registration: &builderv1.SignedValidatorRegistration{},
+			err:          "registration message missing",
+		},
+		{
+			name:         "WrongForkVersion",
+			registration: registration(t),
+			err:          "signature does not verify",
+		},
+		{
+			name:         "WrongMessage",
+			registration: wrongGasLimit,
+			forkVersion:  goerliForkVersion,
+			err:          "signature does not verify",
+		},
+		{
+			name:         "InvalidSignature",
+			registration: badSignature,
+			forkVersion:  goerliForkVersion,
+			err:          "invalid signature",
+		},
+		{
+			name:         "Good",
+			registration: registration(t),
+			forkVersion:  goerliForkVersion,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := verify.VerifyRegistration(test.registration, test.forkVersion)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyQueuedProposers(t *testing.T) {
+	wrongGasLimit := registration(t)
+	wrongGasLimit.Message.GasLimit = 30000001
+
+	proposers := []*v1.QueuedProposer{
+		{Slot: 1, Entry: registration(t)},
+		nil,
+		{Slot: 2, Entry: wrongGasLimit},
+	}
+
+	valid, invalid := verify.VerifyQueuedProposers(proposers, phase0.Version{0x00, 0x00, 0x10, 0x20})
+	require.Len(t, valid, 1)
+	require.Equal(t, phase0.Slot(1), valid[0].Slot)
+	require.Len(t, invalid, 1)
+	require.Equal(t, phase0.Slot(2), invalid[0].Proposer.Slot)
+	require.EqualError(t, invalid[0].Err, "signature does not verify")
+}
@@ -0,0 +1,195 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watcher
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// seenSlots is the number of slots for which emitted deliveries are remembered.
+const seenSlots = 64
+
+// Delivery is the outcome of watching a relay for a single slot.
+type Delivery struct {
+	Relay string
+	Slot  phase0.Slot
+	// Trace is the delivered payload's bid trace, or nil if the relay did not
+	// report a delivery within the grace period.
+	Trace *v1.BidTrace
+	// Err is the error from the final poll of the relay, if any.
+	Err error
+}
+
+// Delivered returns true if the relay delivered a payload for the slot.
+func (d *Delivery) Delivered() bool {
+	return d.Trace != nil
+}
+
+type relaySlot struct {
+	relay string
+	slot  phase0.Slot
+}
+
+// Start starts watching the relays from the current slot onwards.
+// Each relay produces exactly one delivery per slot, which is sent on the
+// returned channel.  The channel is closed once the context is done.
+func (s *Service) Start(ctx context.Context) (<-chan *Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.started {
+		return nil, errors.New("already started")
+	}
+	s.started = true
+
+	deliveries := make(chan *Delivery, s.bufferSize)
+	go s.run(ctx, deliveries)
+
+	return deliveries, nil
+}
+
+// Run watches the relays from the current slot onwards, calling the handler
+// for each delivery.  It blocks until the context is done.
+func (s *Service) Run(ctx context.Context, handler func(*Delivery)) error {
+	deliveries, err := s.Start(ctx)
+	if err != nil {
+		return err
+	}
+	for delivery := range deliveries {
+		handler(delivery)
+	}
+
+	return nil
+}
+
+// run schedules polls for each slot in turn.  Slots are scheduled from their
+// end time rather than a ticker, so if the watcher falls behind it catches up
+// on the intervening slots rather than skipping them.
+func (s *Service) run(ctx context.Context, deliveries chan<- *Delivery) {
+	var wg sync.WaitGroup
+	defer func() {
+		wg.Wait()
+		close(deliveries)
+	}()
+
+	for slot := s.chainTime.CurrentSlot(); ; slot++ {
+		slotEnd := s.chainTime.StartOfSlot(slot + 1)
+		timer := time.NewTimer(time.Until(slotEnd.Add(s.pollDelay)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.prune(slot)
+		deadline := slotEnd.Add(s.gracePeriod)
+		for _, relay := range s.relays {
+			wg.Add(1)
+			go func(relay client.DeliveredBidTraceProvider) {
+				defer wg.Done()
+				s.watch(ctx, relay, slot, deadline, deliveries)
+			}(relay)
+		}
+	}
+}
+
+// watch polls a relay for its delivery in a slot until it is found or the
+// deadline passes.
+func (s *Service) watch(ctx context.Context,
+	relay client.DeliveredBidTraceProvider,
+	slot phase0.Slot,
+	deadline time.Time,
+	deliveries chan<- *Delivery,
+) {
+	log := log.With().Str("relay", relay.Name()).Uint64("slot", uint64(slot)).Logger()
+
+	for {
+		trace, err := relay.DeliveredBidTrace(ctx, slot)
+		if ctx.Err() != nil {
+			return
+		}
+		switch {
+		case err != nil:
+			log.Debug().Err(err).Msg("Failed to obtain delivered bid trace")
+		case trace != nil && trace.Slot != slot:
+			log.Warn().Uint64("trace_slot", uint64(trace.Slot)).Msg("Relay returned delivery for incorrect slot; ignoring")
+		case trace != nil:
+			s.emit(ctx, deliveries, &Delivery{
+				Relay: relay.Name(),
+				Slot:  slot,
+				Trace: trace,
+			})
+
+			return
+		}
+
+		if time.Now().Add(s.retryInterval).After(deadline) {
+			log.Trace().Msg("No delivery within grace period")
+			s.emit(ctx, deliveries, &Delivery{
+				Relay: relay.Name(),
+				Slot:  slot,
+				Err:   err,
+			})
+
+			return
+		}
+
+		timer := time.NewTimer(s.retryInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// emit sends a delivery, unless one has already been sent for the relay and slot.
+func (s *Service) emit(ctx context.Context, deliveries chan<- *Delivery, delivery *Delivery) {
+	key := relaySlot{relay: delivery.Relay, slot: delivery.Slot}
+	s.mu.Lock()
+	_, seen := s.seen[key]
+	s.seen[key] = struct{}{}
+	s.mu.Unlock()
+	if seen {
+		log.Trace().Str("relay", delivery.Relay).Uint64("slot", uint64(delivery.Slot)).Msg("Delivery already emitted")
+		return
+	}
+
+	select {
+	case deliveries <- delivery:
+	case <-ctx.Done():
+	}
+}
+
+// prune forgets deliveries that are too old to be emitted again.
+func (s *Service) prune(slot phase0.Slot) {
+	if slot < seenSlots {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.seen {
+		if key.slot < slot-seenSlots {
+			delete(s.seen, key)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watcher
+
+import (
+	"time"
+
+	client "github.com/attestantio/go-relay-client"
+	"github.com/attestantio/go-relay-client/chaintime"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel      zerolog.Level
+	relays        []client.DeliveredBidTraceProvider
+	chainTime     *chaintime.Service
+	pollDelay     time.Duration
+	retryInterval time.Duration
+	gracePeriod   time.Duration
+	bufferSize    int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithRelays sets the relays to watch.
+func WithRelays(relays []client.DeliveredBidTraceProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.relays = relays
+	})
+}
+
+// WithChainTime sets the chain time service used to schedule polls.
+func WithChainTime(service *chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = service
+	})
+}
+
+// WithPollDelay sets the delay after the end of a slot before the relays
+// are first polled for its delivery.
+func WithPollDelay(delay time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.pollDelay = delay
+	})
+}
+
+// WithRetryInterval sets the interval between polls for a delivery that has
+// not yet been seen.
+func WithRetryInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retryInterval = interval
+	})
+}
+
+// WithGracePeriod sets the period after the end of a slot during which a
+// relay that has not reported a delivery is polled again.  Once it expires
+// the slot is reported as having no delivery.
+func WithGracePeriod(period time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.gracePeriod = period
+	})
+}
+
+// WithBufferSize sets the size of the buffer of the deliveries channel.
+func WithBufferSize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.bufferSize = size
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:      zerolog.GlobalLevel(),
+		pollDelay:     time.Second,
+		retryInterval: 2 * time.Second,
+		gracePeriod:   12 * time.Second,
+		bufferSize:    64,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if len(parameters.relays) == 0 {
+		return nil, errors.New("no relays specified")
+	}
+	if parameters.chainTime == nil {
+		return nil, errors.New("no chain time specified")
+	}
+	if parameters.pollDelay < 0 {
+		return nil, errors.New("poll delay cannot be negative")
+	}
+	if parameters.retryInterval <= 0 {
+		return nil, errors.New("retry interval must be positive")
+	}
+	if parameters.gracePeriod < parameters.pollDelay {
+		return nil, errors.New("grace period cannot be less than poll delay")
+	}
+	if parameters.bufferSize < 0 {
+		return nil, errors.New("buffer size cannot be negative")
+	}
+
+	return &parameters, nil
+}
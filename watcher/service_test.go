@@ -0,0 +1,207 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watcher_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-relay-client"
+	v1 "github.com/attestantio/go-relay-client/api/v1"
+	"github.com/attestantio/go-relay-client/chaintime"
+	"github.com/attestantio/go-relay-client/watcher"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// relay is a mock relay that reports a delivery after a number of polls.
+type relay struct {
+	name string
+	// deliverAfter is the number of polls before the delivery is reported,
+	// or -1 if it is never reported.
+	deliverAfter int
+	err          error
+
+	mu    sync.Mutex
+	polls map[phase0.Slot]int
+}
+
+func (r *relay) Name() string              { return r.name }
+func (r *relay) Address() string           { return r.name }
+func (r *relay) Pubkey() *phase0.BLSPubKey { return nil }
+
+func (r *relay) DeliveredBidTrace(_ context.Context, slot phase0.Slot) (*v1.BidTrace, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.polls == nil {
+		r.polls = make(map[phase0.Slot]int)
+	}
+	polls := r.polls[slot]
+	r.polls[slot]++
+
+	if r.err != nil {
+		return nil, r.err
+	}
+	if r.deliverAfter < 0 || polls < r.deliverAfter {
+		return nil, nil
+	}
+
+	return &v1.BidTrace{Slot: slot}, nil
+}
+
+func testChainTime(t *testing.T) *chaintime.Service {
+	t.Helper()
+
+	chainTime, err := chaintime.New(context.Background(),
+		chaintime.WithGenesisTime(time.Now().Add(-time.Second)),
+		chaintime.WithSlotDuration(200*time.Millisecond),
+		chaintime.WithSlotsPerEpoch(32),
+	)
+	require.NoError(t, err)
+
+	return chainTime
+}
+
+func TestNew(t *testing.T) {
+	chainTime := testChainTime(t)
+	relays := []client.DeliveredBidTraceProvider{&relay{name: "relay"}}
+
+	tests := []struct {
+		name   string
+		params []watcher.Parameter
+		err    string
+	}{
+		{
+			name: "RelaysMissing",
+			params: []watcher.Parameter{
+				watcher.WithChainTime(chainTime),
+			},
+			err: "problem with parameters: no relays specified",
+		},
+		{
+			name: "ChainTimeMissing",
+			params: []watcher.Parameter{
+				watcher.WithRelays(relays),
+			},
+			err: "problem with parameters: no chain time specified",
+		},
+		{
+			name: "RetryIntervalZero",
+			params: []watcher.Parameter{
+				watcher.WithRelays(relays),
+				watcher.WithChainTime(chainTime),
+				watcher.WithRetryInterval(0),
+			},
+			err: "problem with parameters: retry interval must be positive",
+		},
+		{
+			name: "GracePeriodTooShort",
+			params: []watcher.Parameter{
+				watcher.WithRelays(relays),
+				watcher.WithChainTime(chainTime),
+				watcher.WithPollDelay(2 * time.Second),
+				watcher.WithGracePeriod(time.Second),
+			},
+			err: "problem with parameters: grace period cannot be less than poll delay",
+		},
+		{
+			name: "Good",
+			params: []watcher.Parameter{
+				watcher.WithRelays(relays),
+				watcher.WithChainTime(chainTime),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := watcher.New(context.Background(), test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStart(t *testing.T) {
+	relays := []client.DeliveredBidTraceProvider{
+		&relay{name: "immediate", deliverAfter: 0},
+		&relay{name: "late", deliverAfter: 2},
+		&relay{name: "never", deliverAfter: -1},
+		&relay{name: "failing", err: errors.New("mock error")},
+	}
+
+	s, err := watcher.New(context.Background(),
+		watcher.WithRelays(relays),
+		watcher.WithChainTime(testChainTime(t)),
+		watcher.WithPollDelay(10*time.Millisecond),
+		watcher.WithRetryInterval(20*time.Millisecond),
+		watcher.WithGracePeriod(100*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	deliveries, err := s.Start(ctx)
+	require.NoError(t, err)
+
+	_, err = s.Start(ctx)
+	require.EqualError(t, err, "already started")
+
+	// Gather the deliveries for the first slot watched.
+	results := make(map[string]*watcher.Delivery)
+	var slot phase0.Slot
+	for len(results) < len(relays) {
+		select {
+		case delivery := <-deliveries:
+			if len(results) == 0 {
+				slot = delivery.Slot
+			}
+			if delivery.Slot != slot {
+				continue
+			}
+			_, exists := results[delivery.Relay]
+			require.False(t, exists, "duplicate delivery for %s", delivery.Relay)
+			results[delivery.Relay] = delivery
+		case <-time.After(2 * time.Second):
+			require.FailNow(t, "timed out waiting for deliveries")
+		}
+	}
+
+	require.True(t, results["immediate"].Delivered())
+	require.True(t, results["late"].Delivered())
+	require.Equal(t, slot, results["late"].Trace.Slot)
+	require.False(t, results["never"].Delivered())
+	require.NoError(t, results["never"].Err)
+	require.False(t, results["failing"].Delivered())
+	require.EqualError(t, results["failing"].Err, "mock error")
+
+	// Channel should close once the context is cancelled.
+	cancel()
+	for {
+		select {
+		case _, ok := <-deliveries:
+			if !ok {
+				return
+			}
+		case <-time.After(2 * time.Second):
+			require.FailNow(t, "timed out waiting for channel to close")
+		}
+	}
+}